@@ -0,0 +1,1850 @@
+// Code generated by protoc-gen-go-pulsar. DO NOT EDIT.
+package kudos
+
+import (
+	fmt "fmt"
+	runtime "github.com/cosmos/cosmos-proto/runtime"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoiface "google.golang.org/protobuf/runtime/protoiface"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	io "io"
+	reflect "reflect"
+	sync "sync"
+)
+
+var _ protoreflect.List = (*_Params_16_list)(nil)
+
+type _Params_16_list struct {
+	list *[]string
+}
+
+func (x *_Params_16_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_Params_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_16_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field AllowedTipDenoms as it is not of Message kind"))
+}
+
+func (x *_Params_16_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_16_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_Params_16_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_Params                             protoreflect.MessageDescriptor
+	fd_Params_daily_limit                 protoreflect.FieldDescriptor
+	fd_Params_quota_window_seconds        protoreflect.FieldDescriptor
+	fd_Params_max_comment_length          protoreflect.FieldDescriptor
+	fd_Params_default_leaderboard_limit   protoreflect.FieldDescriptor
+	fd_Params_revoke_grace_period_seconds protoreflect.FieldDescriptor
+	fd_Params_decay_percent               protoreflect.FieldDescriptor
+	fd_Params_decay_epoch_seconds         protoreflect.FieldDescriptor
+	fd_Params_decay_accounts_per_block    protoreflect.FieldDescriptor
+	fd_Params_season_length_seconds       protoreflect.FieldDescriptor
+	fd_Params_season_accounts_per_block   protoreflect.FieldDescriptor
+	fd_Params_budget_enabled              protoreflect.FieldDescriptor
+	fd_Params_budget_per_epoch            protoreflect.FieldDescriptor
+	fd_Params_budget_epoch_seconds        protoreflect.FieldDescriptor
+	fd_Params_budget_rollover_cap         protoreflect.FieldDescriptor
+	fd_Params_budget_admin                protoreflect.FieldDescriptor
+	fd_Params_allowed_tip_denoms          protoreflect.FieldDescriptor
+	fd_Params_redeem_denom                protoreflect.FieldDescriptor
+	fd_Params_redeem_rate                 protoreflect.FieldDescriptor
+	fd_Params_redeem_epoch_seconds        protoreflect.FieldDescriptor
+	fd_Params_redeem_cap_per_epoch        protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_kudos_params_proto_init()
+	md_Params = File_kudos_params_proto.Messages().ByName("Params")
+	fd_Params_daily_limit = md_Params.Fields().ByName("daily_limit")
+	fd_Params_quota_window_seconds = md_Params.Fields().ByName("quota_window_seconds")
+	fd_Params_max_comment_length = md_Params.Fields().ByName("max_comment_length")
+	fd_Params_default_leaderboard_limit = md_Params.Fields().ByName("default_leaderboard_limit")
+	fd_Params_revoke_grace_period_seconds = md_Params.Fields().ByName("revoke_grace_period_seconds")
+	fd_Params_decay_percent = md_Params.Fields().ByName("decay_percent")
+	fd_Params_decay_epoch_seconds = md_Params.Fields().ByName("decay_epoch_seconds")
+	fd_Params_decay_accounts_per_block = md_Params.Fields().ByName("decay_accounts_per_block")
+	fd_Params_season_length_seconds = md_Params.Fields().ByName("season_length_seconds")
+	fd_Params_season_accounts_per_block = md_Params.Fields().ByName("season_accounts_per_block")
+	fd_Params_budget_enabled = md_Params.Fields().ByName("budget_enabled")
+	fd_Params_budget_per_epoch = md_Params.Fields().ByName("budget_per_epoch")
+	fd_Params_budget_epoch_seconds = md_Params.Fields().ByName("budget_epoch_seconds")
+	fd_Params_budget_rollover_cap = md_Params.Fields().ByName("budget_rollover_cap")
+	fd_Params_budget_admin = md_Params.Fields().ByName("budget_admin")
+	fd_Params_allowed_tip_denoms = md_Params.Fields().ByName("allowed_tip_denoms")
+	fd_Params_redeem_denom = md_Params.Fields().ByName("redeem_denom")
+	fd_Params_redeem_rate = md_Params.Fields().ByName("redeem_rate")
+	fd_Params_redeem_epoch_seconds = md_Params.Fields().ByName("redeem_epoch_seconds")
+	fd_Params_redeem_cap_per_epoch = md_Params.Fields().ByName("redeem_cap_per_epoch")
+}
+
+var _ protoreflect.Message = (*fastReflection_Params)(nil)
+
+type fastReflection_Params Params
+
+func (x *Params) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Params)(x)
+}
+
+func (x *Params) slowProtoReflect() protoreflect.Message {
+	mi := &file_kudos_params_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Params_messageType fastReflection_Params_messageType
+var _ protoreflect.MessageType = fastReflection_Params_messageType{}
+
+type fastReflection_Params_messageType struct{}
+
+func (x fastReflection_Params_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Params)(nil)
+}
+func (x fastReflection_Params_messageType) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+func (x fastReflection_Params_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Params) Descriptor() protoreflect.MessageDescriptor {
+	return md_Params
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Params) Type() protoreflect.MessageType {
+	return _fastReflection_Params_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Params) New() protoreflect.Message {
+	return new(fastReflection_Params)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Params) Interface() protoreflect.ProtoMessage {
+	return (*Params)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.DailyLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DailyLimit)
+		if !f(fd_Params_daily_limit, value) {
+			return
+		}
+	}
+	if x.QuotaWindowSeconds != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.QuotaWindowSeconds)
+		if !f(fd_Params_quota_window_seconds, value) {
+			return
+		}
+	}
+	if x.MaxCommentLength != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.MaxCommentLength)
+		if !f(fd_Params_max_comment_length, value) {
+			return
+		}
+	}
+	if x.DefaultLeaderboardLimit != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.DefaultLeaderboardLimit)
+		if !f(fd_Params_default_leaderboard_limit, value) {
+			return
+		}
+	}
+	if x.RevokeGracePeriodSeconds != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RevokeGracePeriodSeconds)
+		if !f(fd_Params_revoke_grace_period_seconds, value) {
+			return
+		}
+	}
+	if x.DecayPercent != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.DecayPercent)
+		if !f(fd_Params_decay_percent, value) {
+			return
+		}
+	}
+	if x.DecayEpochSeconds != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DecayEpochSeconds)
+		if !f(fd_Params_decay_epoch_seconds, value) {
+			return
+		}
+	}
+	if x.DecayAccountsPerBlock != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.DecayAccountsPerBlock)
+		if !f(fd_Params_decay_accounts_per_block, value) {
+			return
+		}
+	}
+	if x.SeasonLengthSeconds != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SeasonLengthSeconds)
+		if !f(fd_Params_season_length_seconds, value) {
+			return
+		}
+	}
+	if x.SeasonAccountsPerBlock != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.SeasonAccountsPerBlock)
+		if !f(fd_Params_season_accounts_per_block, value) {
+			return
+		}
+	}
+	if x.BudgetEnabled != false {
+		value := protoreflect.ValueOfBool(x.BudgetEnabled)
+		if !f(fd_Params_budget_enabled, value) {
+			return
+		}
+	}
+	if x.BudgetPerEpoch != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BudgetPerEpoch)
+		if !f(fd_Params_budget_per_epoch, value) {
+			return
+		}
+	}
+	if x.BudgetEpochSeconds != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BudgetEpochSeconds)
+		if !f(fd_Params_budget_epoch_seconds, value) {
+			return
+		}
+	}
+	if x.BudgetRolloverCap != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BudgetRolloverCap)
+		if !f(fd_Params_budget_rollover_cap, value) {
+			return
+		}
+	}
+	if x.BudgetAdmin != "" {
+		value := protoreflect.ValueOfString(x.BudgetAdmin)
+		if !f(fd_Params_budget_admin, value) {
+			return
+		}
+	}
+	if len(x.AllowedTipDenoms) != 0 {
+		value := protoreflect.ValueOfList(&_Params_16_list{list: &x.AllowedTipDenoms})
+		if !f(fd_Params_allowed_tip_denoms, value) {
+			return
+		}
+	}
+	if x.RedeemDenom != "" {
+		value := protoreflect.ValueOfString(x.RedeemDenom)
+		if !f(fd_Params_redeem_denom, value) {
+			return
+		}
+	}
+	if x.RedeemRate != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RedeemRate)
+		if !f(fd_Params_redeem_rate, value) {
+			return
+		}
+	}
+	if x.RedeemEpochSeconds != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RedeemEpochSeconds)
+		if !f(fd_Params_redeem_epoch_seconds, value) {
+			return
+		}
+	}
+	if x.RedeemCapPerEpoch != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RedeemCapPerEpoch)
+		if !f(fd_Params_redeem_cap_per_epoch, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "kudos.Params.daily_limit":
+		return x.DailyLimit != uint64(0)
+	case "kudos.Params.quota_window_seconds":
+		return x.QuotaWindowSeconds != uint64(0)
+	case "kudos.Params.max_comment_length":
+		return x.MaxCommentLength != uint32(0)
+	case "kudos.Params.default_leaderboard_limit":
+		return x.DefaultLeaderboardLimit != uint32(0)
+	case "kudos.Params.revoke_grace_period_seconds":
+		return x.RevokeGracePeriodSeconds != uint64(0)
+	case "kudos.Params.decay_percent":
+		return x.DecayPercent != uint32(0)
+	case "kudos.Params.decay_epoch_seconds":
+		return x.DecayEpochSeconds != uint64(0)
+	case "kudos.Params.decay_accounts_per_block":
+		return x.DecayAccountsPerBlock != uint32(0)
+	case "kudos.Params.season_length_seconds":
+		return x.SeasonLengthSeconds != uint64(0)
+	case "kudos.Params.season_accounts_per_block":
+		return x.SeasonAccountsPerBlock != uint32(0)
+	case "kudos.Params.budget_enabled":
+		return x.BudgetEnabled != false
+	case "kudos.Params.budget_per_epoch":
+		return x.BudgetPerEpoch != uint64(0)
+	case "kudos.Params.budget_epoch_seconds":
+		return x.BudgetEpochSeconds != uint64(0)
+	case "kudos.Params.budget_rollover_cap":
+		return x.BudgetRolloverCap != uint64(0)
+	case "kudos.Params.budget_admin":
+		return x.BudgetAdmin != ""
+	case "kudos.Params.allowed_tip_denoms":
+		return len(x.AllowedTipDenoms) != 0
+	case "kudos.Params.redeem_denom":
+		return x.RedeemDenom != ""
+	case "kudos.Params.redeem_rate":
+		return x.RedeemRate != uint64(0)
+	case "kudos.Params.redeem_epoch_seconds":
+		return x.RedeemEpochSeconds != uint64(0)
+	case "kudos.Params.redeem_cap_per_epoch":
+		return x.RedeemCapPerEpoch != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kudos.Params"))
+		}
+		panic(fmt.Errorf("message kudos.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "kudos.Params.daily_limit":
+		x.DailyLimit = uint64(0)
+	case "kudos.Params.quota_window_seconds":
+		x.QuotaWindowSeconds = uint64(0)
+	case "kudos.Params.max_comment_length":
+		x.MaxCommentLength = uint32(0)
+	case "kudos.Params.default_leaderboard_limit":
+		x.DefaultLeaderboardLimit = uint32(0)
+	case "kudos.Params.revoke_grace_period_seconds":
+		x.RevokeGracePeriodSeconds = uint64(0)
+	case "kudos.Params.decay_percent":
+		x.DecayPercent = uint32(0)
+	case "kudos.Params.decay_epoch_seconds":
+		x.DecayEpochSeconds = uint64(0)
+	case "kudos.Params.decay_accounts_per_block":
+		x.DecayAccountsPerBlock = uint32(0)
+	case "kudos.Params.season_length_seconds":
+		x.SeasonLengthSeconds = uint64(0)
+	case "kudos.Params.season_accounts_per_block":
+		x.SeasonAccountsPerBlock = uint32(0)
+	case "kudos.Params.budget_enabled":
+		x.BudgetEnabled = false
+	case "kudos.Params.budget_per_epoch":
+		x.BudgetPerEpoch = uint64(0)
+	case "kudos.Params.budget_epoch_seconds":
+		x.BudgetEpochSeconds = uint64(0)
+	case "kudos.Params.budget_rollover_cap":
+		x.BudgetRolloverCap = uint64(0)
+	case "kudos.Params.budget_admin":
+		x.BudgetAdmin = ""
+	case "kudos.Params.allowed_tip_denoms":
+		x.AllowedTipDenoms = nil
+	case "kudos.Params.redeem_denom":
+		x.RedeemDenom = ""
+	case "kudos.Params.redeem_rate":
+		x.RedeemRate = uint64(0)
+	case "kudos.Params.redeem_epoch_seconds":
+		x.RedeemEpochSeconds = uint64(0)
+	case "kudos.Params.redeem_cap_per_epoch":
+		x.RedeemCapPerEpoch = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kudos.Params"))
+		}
+		panic(fmt.Errorf("message kudos.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "kudos.Params.daily_limit":
+		value := x.DailyLimit
+		return protoreflect.ValueOfUint64(value)
+	case "kudos.Params.quota_window_seconds":
+		value := x.QuotaWindowSeconds
+		return protoreflect.ValueOfUint64(value)
+	case "kudos.Params.max_comment_length":
+		value := x.MaxCommentLength
+		return protoreflect.ValueOfUint32(value)
+	case "kudos.Params.default_leaderboard_limit":
+		value := x.DefaultLeaderboardLimit
+		return protoreflect.ValueOfUint32(value)
+	case "kudos.Params.revoke_grace_period_seconds":
+		value := x.RevokeGracePeriodSeconds
+		return protoreflect.ValueOfUint64(value)
+	case "kudos.Params.decay_percent":
+		value := x.DecayPercent
+		return protoreflect.ValueOfUint32(value)
+	case "kudos.Params.decay_epoch_seconds":
+		value := x.DecayEpochSeconds
+		return protoreflect.ValueOfUint64(value)
+	case "kudos.Params.decay_accounts_per_block":
+		value := x.DecayAccountsPerBlock
+		return protoreflect.ValueOfUint32(value)
+	case "kudos.Params.season_length_seconds":
+		value := x.SeasonLengthSeconds
+		return protoreflect.ValueOfUint64(value)
+	case "kudos.Params.season_accounts_per_block":
+		value := x.SeasonAccountsPerBlock
+		return protoreflect.ValueOfUint32(value)
+	case "kudos.Params.budget_enabled":
+		value := x.BudgetEnabled
+		return protoreflect.ValueOfBool(value)
+	case "kudos.Params.budget_per_epoch":
+		value := x.BudgetPerEpoch
+		return protoreflect.ValueOfUint64(value)
+	case "kudos.Params.budget_epoch_seconds":
+		value := x.BudgetEpochSeconds
+		return protoreflect.ValueOfUint64(value)
+	case "kudos.Params.budget_rollover_cap":
+		value := x.BudgetRolloverCap
+		return protoreflect.ValueOfUint64(value)
+	case "kudos.Params.budget_admin":
+		value := x.BudgetAdmin
+		return protoreflect.ValueOfString(value)
+	case "kudos.Params.allowed_tip_denoms":
+		if len(x.AllowedTipDenoms) == 0 {
+			return protoreflect.ValueOfList(&_Params_16_list{})
+		}
+		listValue := &_Params_16_list{list: &x.AllowedTipDenoms}
+		return protoreflect.ValueOfList(listValue)
+	case "kudos.Params.redeem_denom":
+		value := x.RedeemDenom
+		return protoreflect.ValueOfString(value)
+	case "kudos.Params.redeem_rate":
+		value := x.RedeemRate
+		return protoreflect.ValueOfUint64(value)
+	case "kudos.Params.redeem_epoch_seconds":
+		value := x.RedeemEpochSeconds
+		return protoreflect.ValueOfUint64(value)
+	case "kudos.Params.redeem_cap_per_epoch":
+		value := x.RedeemCapPerEpoch
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kudos.Params"))
+		}
+		panic(fmt.Errorf("message kudos.Params does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "kudos.Params.daily_limit":
+		x.DailyLimit = value.Uint()
+	case "kudos.Params.quota_window_seconds":
+		x.QuotaWindowSeconds = value.Uint()
+	case "kudos.Params.max_comment_length":
+		x.MaxCommentLength = uint32(value.Uint())
+	case "kudos.Params.default_leaderboard_limit":
+		x.DefaultLeaderboardLimit = uint32(value.Uint())
+	case "kudos.Params.revoke_grace_period_seconds":
+		x.RevokeGracePeriodSeconds = value.Uint()
+	case "kudos.Params.decay_percent":
+		x.DecayPercent = uint32(value.Uint())
+	case "kudos.Params.decay_epoch_seconds":
+		x.DecayEpochSeconds = value.Uint()
+	case "kudos.Params.decay_accounts_per_block":
+		x.DecayAccountsPerBlock = uint32(value.Uint())
+	case "kudos.Params.season_length_seconds":
+		x.SeasonLengthSeconds = value.Uint()
+	case "kudos.Params.season_accounts_per_block":
+		x.SeasonAccountsPerBlock = uint32(value.Uint())
+	case "kudos.Params.budget_enabled":
+		x.BudgetEnabled = value.Bool()
+	case "kudos.Params.budget_per_epoch":
+		x.BudgetPerEpoch = value.Uint()
+	case "kudos.Params.budget_epoch_seconds":
+		x.BudgetEpochSeconds = value.Uint()
+	case "kudos.Params.budget_rollover_cap":
+		x.BudgetRolloverCap = value.Uint()
+	case "kudos.Params.budget_admin":
+		x.BudgetAdmin = value.Interface().(string)
+	case "kudos.Params.allowed_tip_denoms":
+		lv := value.List()
+		clv := lv.(*_Params_16_list)
+		x.AllowedTipDenoms = *clv.list
+	case "kudos.Params.redeem_denom":
+		x.RedeemDenom = value.Interface().(string)
+	case "kudos.Params.redeem_rate":
+		x.RedeemRate = value.Uint()
+	case "kudos.Params.redeem_epoch_seconds":
+		x.RedeemEpochSeconds = value.Uint()
+	case "kudos.Params.redeem_cap_per_epoch":
+		x.RedeemCapPerEpoch = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kudos.Params"))
+		}
+		panic(fmt.Errorf("message kudos.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kudos.Params.allowed_tip_denoms":
+		if x.AllowedTipDenoms == nil {
+			x.AllowedTipDenoms = []string{}
+		}
+		value := &_Params_16_list{list: &x.AllowedTipDenoms}
+		return protoreflect.ValueOfList(value)
+	case "kudos.Params.daily_limit":
+		panic(fmt.Errorf("field daily_limit of message kudos.Params is not mutable"))
+	case "kudos.Params.quota_window_seconds":
+		panic(fmt.Errorf("field quota_window_seconds of message kudos.Params is not mutable"))
+	case "kudos.Params.max_comment_length":
+		panic(fmt.Errorf("field max_comment_length of message kudos.Params is not mutable"))
+	case "kudos.Params.default_leaderboard_limit":
+		panic(fmt.Errorf("field default_leaderboard_limit of message kudos.Params is not mutable"))
+	case "kudos.Params.revoke_grace_period_seconds":
+		panic(fmt.Errorf("field revoke_grace_period_seconds of message kudos.Params is not mutable"))
+	case "kudos.Params.decay_percent":
+		panic(fmt.Errorf("field decay_percent of message kudos.Params is not mutable"))
+	case "kudos.Params.decay_epoch_seconds":
+		panic(fmt.Errorf("field decay_epoch_seconds of message kudos.Params is not mutable"))
+	case "kudos.Params.decay_accounts_per_block":
+		panic(fmt.Errorf("field decay_accounts_per_block of message kudos.Params is not mutable"))
+	case "kudos.Params.season_length_seconds":
+		panic(fmt.Errorf("field season_length_seconds of message kudos.Params is not mutable"))
+	case "kudos.Params.season_accounts_per_block":
+		panic(fmt.Errorf("field season_accounts_per_block of message kudos.Params is not mutable"))
+	case "kudos.Params.budget_enabled":
+		panic(fmt.Errorf("field budget_enabled of message kudos.Params is not mutable"))
+	case "kudos.Params.budget_per_epoch":
+		panic(fmt.Errorf("field budget_per_epoch of message kudos.Params is not mutable"))
+	case "kudos.Params.budget_epoch_seconds":
+		panic(fmt.Errorf("field budget_epoch_seconds of message kudos.Params is not mutable"))
+	case "kudos.Params.budget_rollover_cap":
+		panic(fmt.Errorf("field budget_rollover_cap of message kudos.Params is not mutable"))
+	case "kudos.Params.budget_admin":
+		panic(fmt.Errorf("field budget_admin of message kudos.Params is not mutable"))
+	case "kudos.Params.redeem_denom":
+		panic(fmt.Errorf("field redeem_denom of message kudos.Params is not mutable"))
+	case "kudos.Params.redeem_rate":
+		panic(fmt.Errorf("field redeem_rate of message kudos.Params is not mutable"))
+	case "kudos.Params.redeem_epoch_seconds":
+		panic(fmt.Errorf("field redeem_epoch_seconds of message kudos.Params is not mutable"))
+	case "kudos.Params.redeem_cap_per_epoch":
+		panic(fmt.Errorf("field redeem_cap_per_epoch of message kudos.Params is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kudos.Params"))
+		}
+		panic(fmt.Errorf("message kudos.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "kudos.Params.daily_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "kudos.Params.quota_window_seconds":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "kudos.Params.max_comment_length":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "kudos.Params.default_leaderboard_limit":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "kudos.Params.revoke_grace_period_seconds":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "kudos.Params.decay_percent":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "kudos.Params.decay_epoch_seconds":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "kudos.Params.decay_accounts_per_block":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "kudos.Params.season_length_seconds":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "kudos.Params.season_accounts_per_block":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "kudos.Params.budget_enabled":
+		return protoreflect.ValueOfBool(false)
+	case "kudos.Params.budget_per_epoch":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "kudos.Params.budget_epoch_seconds":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "kudos.Params.budget_rollover_cap":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "kudos.Params.budget_admin":
+		return protoreflect.ValueOfString("")
+	case "kudos.Params.allowed_tip_denoms":
+		list := []string{}
+		return protoreflect.ValueOfList(&_Params_16_list{list: &list})
+	case "kudos.Params.redeem_denom":
+		return protoreflect.ValueOfString("")
+	case "kudos.Params.redeem_rate":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "kudos.Params.redeem_epoch_seconds":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "kudos.Params.redeem_cap_per_epoch":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: kudos.Params"))
+		}
+		panic(fmt.Errorf("message kudos.Params does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Params) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in kudos.Params", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Params) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Params) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Params) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.DailyLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.DailyLimit))
+		}
+		if x.QuotaWindowSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.QuotaWindowSeconds))
+		}
+		if x.MaxCommentLength != 0 {
+			n += 1 + runtime.Sov(uint64(x.MaxCommentLength))
+		}
+		if x.DefaultLeaderboardLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.DefaultLeaderboardLimit))
+		}
+		if x.RevokeGracePeriodSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.RevokeGracePeriodSeconds))
+		}
+		if x.DecayPercent != 0 {
+			n += 1 + runtime.Sov(uint64(x.DecayPercent))
+		}
+		if x.DecayEpochSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.DecayEpochSeconds))
+		}
+		if x.DecayAccountsPerBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.DecayAccountsPerBlock))
+		}
+		if x.SeasonLengthSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.SeasonLengthSeconds))
+		}
+		if x.SeasonAccountsPerBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.SeasonAccountsPerBlock))
+		}
+		if x.BudgetEnabled {
+			n += 2
+		}
+		if x.BudgetPerEpoch != 0 {
+			n += 1 + runtime.Sov(uint64(x.BudgetPerEpoch))
+		}
+		if x.BudgetEpochSeconds != 0 {
+			n += 1 + runtime.Sov(uint64(x.BudgetEpochSeconds))
+		}
+		if x.BudgetRolloverCap != 0 {
+			n += 1 + runtime.Sov(uint64(x.BudgetRolloverCap))
+		}
+		l = len(x.BudgetAdmin)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.AllowedTipDenoms) > 0 {
+			for _, s := range x.AllowedTipDenoms {
+				l = len(s)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.RedeemDenom)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.RedeemRate != 0 {
+			n += 2 + runtime.Sov(uint64(x.RedeemRate))
+		}
+		if x.RedeemEpochSeconds != 0 {
+			n += 2 + runtime.Sov(uint64(x.RedeemEpochSeconds))
+		}
+		if x.RedeemCapPerEpoch != 0 {
+			n += 2 + runtime.Sov(uint64(x.RedeemCapPerEpoch))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.RedeemCapPerEpoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RedeemCapPerEpoch))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa0
+		}
+		if x.RedeemEpochSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RedeemEpochSeconds))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x98
+		}
+		if x.RedeemRate != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RedeemRate))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x90
+		}
+		if len(x.RedeemDenom) > 0 {
+			i -= len(x.RedeemDenom)
+			copy(dAtA[i:], x.RedeemDenom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RedeemDenom)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+		if len(x.AllowedTipDenoms) > 0 {
+			for iNdEx := len(x.AllowedTipDenoms) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedTipDenoms[iNdEx])
+				copy(dAtA[i:], x.AllowedTipDenoms[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedTipDenoms[iNdEx])))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
+			}
+		}
+		if len(x.BudgetAdmin) > 0 {
+			i -= len(x.BudgetAdmin)
+			copy(dAtA[i:], x.BudgetAdmin)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BudgetAdmin)))
+			i--
+			dAtA[i] = 0x7a
+		}
+		if x.BudgetRolloverCap != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BudgetRolloverCap))
+			i--
+			dAtA[i] = 0x70
+		}
+		if x.BudgetEpochSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BudgetEpochSeconds))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.BudgetPerEpoch != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BudgetPerEpoch))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.BudgetEnabled {
+			i--
+			if x.BudgetEnabled {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.SeasonAccountsPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SeasonAccountsPerBlock))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.SeasonLengthSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SeasonLengthSeconds))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.DecayAccountsPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DecayAccountsPerBlock))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.DecayEpochSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DecayEpochSeconds))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.DecayPercent != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DecayPercent))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.RevokeGracePeriodSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RevokeGracePeriodSeconds))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.DefaultLeaderboardLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DefaultLeaderboardLimit))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.MaxCommentLength != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.MaxCommentLength))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.QuotaWindowSeconds != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.QuotaWindowSeconds))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.DailyLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DailyLimit))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Params)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DailyLimit", wireType)
+				}
+				x.DailyLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DailyLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field QuotaWindowSeconds", wireType)
+				}
+				x.QuotaWindowSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.QuotaWindowSeconds |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxCommentLength", wireType)
+				}
+				x.MaxCommentLength = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.MaxCommentLength |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DefaultLeaderboardLimit", wireType)
+				}
+				x.DefaultLeaderboardLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DefaultLeaderboardLimit |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevokeGracePeriodSeconds", wireType)
+				}
+				x.RevokeGracePeriodSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RevokeGracePeriodSeconds |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DecayPercent", wireType)
+				}
+				x.DecayPercent = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DecayPercent |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DecayEpochSeconds", wireType)
+				}
+				x.DecayEpochSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DecayEpochSeconds |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DecayAccountsPerBlock", wireType)
+				}
+				x.DecayAccountsPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DecayAccountsPerBlock |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SeasonLengthSeconds", wireType)
+				}
+				x.SeasonLengthSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SeasonLengthSeconds |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SeasonAccountsPerBlock", wireType)
+				}
+				x.SeasonAccountsPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SeasonAccountsPerBlock |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BudgetEnabled", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.BudgetEnabled = bool(v != 0)
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BudgetPerEpoch", wireType)
+				}
+				x.BudgetPerEpoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BudgetPerEpoch |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BudgetEpochSeconds", wireType)
+				}
+				x.BudgetEpochSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BudgetEpochSeconds |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BudgetRolloverCap", wireType)
+				}
+				x.BudgetRolloverCap = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BudgetRolloverCap |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 15:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BudgetAdmin", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BudgetAdmin = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedTipDenoms", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedTipDenoms = append(x.AllowedTipDenoms, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 17:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RedeemDenom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RedeemDenom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 18:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RedeemRate", wireType)
+				}
+				x.RedeemRate = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RedeemRate |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 19:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RedeemEpochSeconds", wireType)
+				}
+				x.RedeemEpochSeconds = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RedeemEpochSeconds |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 20:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RedeemCapPerEpoch", wireType)
+				}
+				x.RedeemCapPerEpoch = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RedeemCapPerEpoch |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: kudos/params.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Params defines the governance-controlled parameters of the kudos module.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// daily_limit is how many kudos an address can send within one quota window
+	DailyLimit uint64 `protobuf:"varint,1,opt,name=daily_limit,json=dailyLimit,proto3" json:"daily_limit,omitempty"`
+	// quota_window_seconds is how long a quota window lasts
+	QuotaWindowSeconds uint64 `protobuf:"varint,2,opt,name=quota_window_seconds,json=quotaWindowSeconds,proto3" json:"quota_window_seconds,omitempty"`
+	// max_comment_length is the maximum length of a kudos comment
+	MaxCommentLength uint32 `protobuf:"varint,3,opt,name=max_comment_length,json=maxCommentLength,proto3" json:"max_comment_length,omitempty"`
+	// default_leaderboard_limit is used when a leaderboard query does not set a limit
+	DefaultLeaderboardLimit uint32 `protobuf:"varint,4,opt,name=default_leaderboard_limit,json=defaultLeaderboardLimit,proto3" json:"default_leaderboard_limit,omitempty"`
+	// revoke_grace_period_seconds is how long after a transfer its sender may revoke it; 0 disables revocation
+	RevokeGracePeriodSeconds uint64 `protobuf:"varint,5,opt,name=revoke_grace_period_seconds,json=revokeGracePeriodSeconds,proto3" json:"revoke_grace_period_seconds,omitempty"`
+	// decay_percent is the share of every balance removed each decay epoch; 0 disables decay
+	DecayPercent uint32 `protobuf:"varint,6,opt,name=decay_percent,json=decayPercent,proto3" json:"decay_percent,omitempty"`
+	// decay_epoch_seconds is how often balances decay
+	DecayEpochSeconds uint64 `protobuf:"varint,7,opt,name=decay_epoch_seconds,json=decayEpochSeconds,proto3" json:"decay_epoch_seconds,omitempty"`
+	// decay_accounts_per_block bounds how many balances the BeginBlocker decays in one block
+	DecayAccountsPerBlock uint32 `protobuf:"varint,8,opt,name=decay_accounts_per_block,json=decayAccountsPerBlock,proto3" json:"decay_accounts_per_block,omitempty"`
+	// season_length_seconds is how long a season lasts; 0 disables seasons
+	SeasonLengthSeconds uint64 `protobuf:"varint,9,opt,name=season_length_seconds,json=seasonLengthSeconds,proto3" json:"season_length_seconds,omitempty"`
+	// season_accounts_per_block bounds how many balances the BeginBlocker archives and resets in one
+	// block when a season ends
+	SeasonAccountsPerBlock uint32 `protobuf:"varint,10,opt,name=season_accounts_per_block,json=seasonAccountsPerBlock,proto3" json:"season_accounts_per_block,omitempty"`
+	// budget_enabled switches sending from unlimited minting to drawing down a per-epoch giveable allowance
+	BudgetEnabled bool `protobuf:"varint,11,opt,name=budget_enabled,json=budgetEnabled,proto3" json:"budget_enabled,omitempty"`
+	// budget_per_epoch is the allowance every address without an override receives each budget epoch
+	BudgetPerEpoch uint64 `protobuf:"varint,12,opt,name=budget_per_epoch,json=budgetPerEpoch,proto3" json:"budget_per_epoch,omitempty"`
+	// budget_epoch_seconds is how often giveable allowances refill
+	BudgetEpochSeconds uint64 `protobuf:"varint,13,opt,name=budget_epoch_seconds,json=budgetEpochSeconds,proto3" json:"budget_epoch_seconds,omitempty"`
+	// budget_rollover_cap is the most unspent allowance carried into the next epoch; 0 disables rollover
+	BudgetRolloverCap uint64 `protobuf:"varint,14,opt,name=budget_rollover_cap,json=budgetRolloverCap,proto3" json:"budget_rollover_cap,omitempty"`
+	// budget_admin is an optional account that may set per-address allowances alongside the module authority
+	BudgetAdmin string `protobuf:"bytes,15,opt,name=budget_admin,json=budgetAdmin,proto3" json:"budget_admin,omitempty"`
+	// allowed_tip_denoms lists the coin denoms that may be attached to kudos as a tip; empty disables tips
+	AllowedTipDenoms []string `protobuf:"bytes,16,rep,name=allowed_tip_denoms,json=allowedTipDenoms,proto3" json:"allowed_tip_denoms,omitempty"`
+	// redeem_denom is the denom the reward pool pays out for redeemed kudos; empty disables redemption
+	RedeemDenom string `protobuf:"bytes,17,opt,name=redeem_denom,json=redeemDenom,proto3" json:"redeem_denom,omitempty"`
+	// redeem_rate is how many units of redeem_denom a single redeemed kudos is worth
+	RedeemRate uint64 `protobuf:"varint,18,opt,name=redeem_rate,json=redeemRate,proto3" json:"redeem_rate,omitempty"`
+	// redeem_epoch_seconds is how often the per-address redemption cap resets
+	RedeemEpochSeconds uint64 `protobuf:"varint,19,opt,name=redeem_epoch_seconds,json=redeemEpochSeconds,proto3" json:"redeem_epoch_seconds,omitempty"`
+	// redeem_cap_per_epoch is the most kudos an address may redeem within one redeem epoch; 0 disables the cap
+	RedeemCapPerEpoch uint64 `protobuf:"varint,20,opt,name=redeem_cap_per_epoch,json=redeemCapPerEpoch,proto3" json:"redeem_cap_per_epoch,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_kudos_params_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_kudos_params_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetDailyLimit() uint64 {
+	if x != nil {
+		return x.DailyLimit
+	}
+	return 0
+}
+
+func (x *Params) GetQuotaWindowSeconds() uint64 {
+	if x != nil {
+		return x.QuotaWindowSeconds
+	}
+	return 0
+}
+
+func (x *Params) GetMaxCommentLength() uint32 {
+	if x != nil {
+		return x.MaxCommentLength
+	}
+	return 0
+}
+
+func (x *Params) GetDefaultLeaderboardLimit() uint32 {
+	if x != nil {
+		return x.DefaultLeaderboardLimit
+	}
+	return 0
+}
+
+func (x *Params) GetRevokeGracePeriodSeconds() uint64 {
+	if x != nil {
+		return x.RevokeGracePeriodSeconds
+	}
+	return 0
+}
+
+func (x *Params) GetDecayPercent() uint32 {
+	if x != nil {
+		return x.DecayPercent
+	}
+	return 0
+}
+
+func (x *Params) GetDecayEpochSeconds() uint64 {
+	if x != nil {
+		return x.DecayEpochSeconds
+	}
+	return 0
+}
+
+func (x *Params) GetDecayAccountsPerBlock() uint32 {
+	if x != nil {
+		return x.DecayAccountsPerBlock
+	}
+	return 0
+}
+
+func (x *Params) GetSeasonLengthSeconds() uint64 {
+	if x != nil {
+		return x.SeasonLengthSeconds
+	}
+	return 0
+}
+
+func (x *Params) GetSeasonAccountsPerBlock() uint32 {
+	if x != nil {
+		return x.SeasonAccountsPerBlock
+	}
+	return 0
+}
+
+func (x *Params) GetBudgetEnabled() bool {
+	if x != nil {
+		return x.BudgetEnabled
+	}
+	return false
+}
+
+func (x *Params) GetBudgetPerEpoch() uint64 {
+	if x != nil {
+		return x.BudgetPerEpoch
+	}
+	return 0
+}
+
+func (x *Params) GetBudgetEpochSeconds() uint64 {
+	if x != nil {
+		return x.BudgetEpochSeconds
+	}
+	return 0
+}
+
+func (x *Params) GetBudgetRolloverCap() uint64 {
+	if x != nil {
+		return x.BudgetRolloverCap
+	}
+	return 0
+}
+
+func (x *Params) GetBudgetAdmin() string {
+	if x != nil {
+		return x.BudgetAdmin
+	}
+	return ""
+}
+
+func (x *Params) GetAllowedTipDenoms() []string {
+	if x != nil {
+		return x.AllowedTipDenoms
+	}
+	return nil
+}
+
+func (x *Params) GetRedeemDenom() string {
+	if x != nil {
+		return x.RedeemDenom
+	}
+	return ""
+}
+
+func (x *Params) GetRedeemRate() uint64 {
+	if x != nil {
+		return x.RedeemRate
+	}
+	return 0
+}
+
+func (x *Params) GetRedeemEpochSeconds() uint64 {
+	if x != nil {
+		return x.RedeemEpochSeconds
+	}
+	return 0
+}
+
+func (x *Params) GetRedeemCapPerEpoch() uint64 {
+	if x != nil {
+		return x.RedeemCapPerEpoch
+	}
+	return 0
+}
+
+var File_kudos_params_proto protoreflect.FileDescriptor
+
+var file_kudos_params_proto_rawDesc = []byte{
+	0x0a, 0x12, 0x6b, 0x75, 0x64, 0x6f, 0x73, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x12, 0x05, 0x6b, 0x75, 0x64, 0x6f, 0x73, 0x1a, 0x14, 0x67, 0x6f, 0x67,
+	0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x9d, 0x0c, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x37, 0x0a, 0x0b,
+	0x64, 0x61, 0x69, 0x6c, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x16, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x61, 0x69,
+	0x6c, 0x79, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x52, 0x0a, 0x64, 0x61, 0x69, 0x6c, 0x79,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x51, 0x0a, 0x14, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x1f, 0xf2, 0xde, 0x1f, 0x1b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x71,
+	0x75, 0x6f, 0x74, 0x61, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x22, 0x52, 0x12, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x4b, 0x0a, 0x12, 0x6d, 0x61, 0x78, 0x5f,
+	0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x1d, 0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x6c, 0x65, 0x6e, 0x67,
+	0x74, 0x68, 0x22, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x4c,
+	0x65, 0x6e, 0x67, 0x74, 0x68, 0x12, 0x60, 0x0a, 0x19, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+	0x5f, 0x6c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x6d,
+	0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x24, 0xf2, 0xde, 0x1f, 0x20, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x5f, 0x6c, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x62, 0x6f, 0x61, 0x72, 0x64, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x22, 0x52, 0x17,
+	0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4c, 0x65, 0x61, 0x64, 0x65, 0x72, 0x62, 0x6f, 0x61,
+	0x72, 0x64, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x65, 0x0a, 0x1b, 0x72, 0x65, 0x76, 0x6f, 0x6b,
+	0x65, 0x5f, 0x67, 0x72, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x26, 0xf2, 0xde,
+	0x1f, 0x22, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x5f, 0x67,
+	0x72, 0x61, 0x63, 0x65, 0x5f, 0x70, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x22, 0x52, 0x18, 0x72, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x47, 0x72, 0x61, 0x63,
+	0x65, 0x50, 0x65, 0x72, 0x69, 0x6f, 0x64, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x3d,
+	0x0a, 0x0d, 0x64, 0x65, 0x63, 0x61, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x18, 0xf2, 0xde, 0x1f, 0x14, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x64, 0x65, 0x63, 0x61, 0x79, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x22, 0x52,
+	0x0c, 0x64, 0x65, 0x63, 0x61, 0x79, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x4e, 0x0a,
+	0x13, 0x64, 0x65, 0x63, 0x61, 0x79, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1e, 0xf2, 0xde, 0x1f, 0x1a,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x65, 0x63, 0x61, 0x79, 0x5f, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x52, 0x11, 0x64, 0x65, 0x63, 0x61,
+	0x79, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x5c, 0x0a,
+	0x18, 0x64, 0x65, 0x63, 0x61, 0x79, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5f,
+	0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x42,
+	0x23, 0xf2, 0xde, 0x1f, 0x1f, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x64, 0x65, 0x63, 0x61, 0x79,
+	0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c,
+	0x6f, 0x63, 0x6b, 0x22, 0x52, 0x15, 0x64, 0x65, 0x63, 0x61, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x54, 0x0a, 0x15, 0x73,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x42, 0x20, 0xf2, 0xde, 0x1f, 0x1c,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x6c, 0x65, 0x6e,
+	0x67, 0x74, 0x68, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x52, 0x13, 0x73, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x4c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x12, 0x5f, 0x0a, 0x19, 0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x24, 0xf2, 0xde, 0x1f, 0x20, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x73, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x5f,
+	0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x16, 0x73, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f,
+	0x63, 0x6b, 0x12, 0x40, 0x0a, 0x0e, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x42, 0x19, 0xf2, 0xde, 0x1f, 0x15,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x22, 0x52, 0x0d, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x45, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x64, 0x12, 0x45, 0x0a, 0x10, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1b,
+	0xf2, 0xde, 0x1f, 0x17, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x52, 0x0e, 0x62, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x50, 0x65, 0x72, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x12, 0x51, 0x0a, 0x14, 0x62,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1f, 0xf2, 0xde, 0x1f, 0x1b, 0x79,
+	0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x65, 0x70, 0x6f, 0x63,
+	0x68, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x52, 0x12, 0x62, 0x75, 0x64, 0x67,
+	0x65, 0x74, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x4e,
+	0x0a, 0x13, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65,
+	0x72, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1e, 0xf2, 0xde, 0x1f,
+	0x1a, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x72, 0x6f,
+	0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x63, 0x61, 0x70, 0x22, 0x52, 0x11, 0x62, 0x75, 0x64,
+	0x67, 0x65, 0x74, 0x52, 0x6f, 0x6c, 0x6c, 0x6f, 0x76, 0x65, 0x72, 0x43, 0x61, 0x70, 0x12, 0x3a,
+	0x0a, 0x0c, 0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x62, 0x75, 0x64, 0x67, 0x65, 0x74, 0x5f, 0x61, 0x64, 0x6d, 0x69, 0x6e, 0x22, 0x52, 0x0b, 0x62,
+	0x75, 0x64, 0x67, 0x65, 0x74, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x12, 0x4b, 0x0a, 0x12, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x70, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73,
+	0x18, 0x10, 0x20, 0x03, 0x28, 0x09, 0x42, 0x1d, 0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x70, 0x5f, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x73, 0x22, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x54, 0x69,
+	0x70, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x12, 0x3a, 0x0a, 0x0c, 0x72, 0x65, 0x64, 0x65, 0x65,
+	0x6d, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x42, 0x17, 0xf2,
+	0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x5f,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x52, 0x0b, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x44, 0x65,
+	0x6e, 0x6f, 0x6d, 0x12, 0x37, 0x0a, 0x0b, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x5f, 0x72, 0x61,
+	0x74, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x42, 0x16, 0xf2, 0xde, 0x1f, 0x12, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x22,
+	0x52, 0x0a, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x52, 0x61, 0x74, 0x65, 0x12, 0x51, 0x0a, 0x14,
+	0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x73, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1f, 0xf2, 0xde, 0x1f, 0x1b,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x5f, 0x65, 0x70, 0x6f,
+	0x63, 0x68, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0x52, 0x12, 0x72, 0x65, 0x64,
+	0x65, 0x65, 0x6d, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12,
+	0x50, 0x0a, 0x14, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x5f, 0x63, 0x61, 0x70, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1f, 0xf2,
+	0xde, 0x1f, 0x1b, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x5f,
+	0x63, 0x61, 0x70, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x22, 0x52, 0x11,
+	0x72, 0x65, 0x64, 0x65, 0x65, 0x6d, 0x43, 0x61, 0x70, 0x50, 0x65, 0x72, 0x45, 0x70, 0x6f, 0x63,
+	0x68, 0x42, 0x39, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x70, 0x61, 0x76, 0x6c, 0x65, 0x6e, 0x6b, 0x6f, 0x74, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x6b, 0x75, 0x64, 0x6f, 0x73, 0x2d, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x2f, 0x78,
+	0x2f, 0x6b, 0x75, 0x64, 0x6f, 0x73, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_kudos_params_proto_rawDescOnce sync.Once
+	file_kudos_params_proto_rawDescData = file_kudos_params_proto_rawDesc
+)
+
+func file_kudos_params_proto_rawDescGZIP() []byte {
+	file_kudos_params_proto_rawDescOnce.Do(func() {
+		file_kudos_params_proto_rawDescData = protoimpl.X.CompressGZIP(file_kudos_params_proto_rawDescData)
+	})
+	return file_kudos_params_proto_rawDescData
+}
+
+var file_kudos_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_kudos_params_proto_goTypes = []interface{}{
+	(*Params)(nil), // 0: kudos.Params
+}
+var file_kudos_params_proto_depIdxs = []int32{
+	0, // [0:0] is the sub-list for method output_type
+	0, // [0:0] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_kudos_params_proto_init() }
+func file_kudos_params_proto_init() {
+	if File_kudos_params_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_kudos_params_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Params); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_kudos_params_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   1,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_kudos_params_proto_goTypes,
+		DependencyIndexes: file_kudos_params_proto_depIdxs,
+		MessageInfos:      file_kudos_params_proto_msgTypes,
+	}.Build()
+	File_kudos_params_proto = out.File
+	file_kudos_params_proto_rawDesc = nil
+	file_kudos_params_proto_goTypes = nil
+	file_kudos_params_proto_depIdxs = nil
+}
@@ -0,0 +1,61 @@
+package app
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	kudostypes "github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+func TestExampleAppExport(t *testing.T) {
+	app, _ := Setup(t)
+
+	exported, err := app.ExportAppStateAndValidators(false, nil, nil)
+	require.NoError(t, err)
+	require.Len(t, exported.Validators, 1)
+	require.Contains(t, string(exported.AppState), `"kudos"`)
+
+	_, err = app.ExportAppStateAndValidators(true, nil, nil)
+	require.NoError(t, err)
+}
+
+func TestExampleAppBlockedAddrs(t *testing.T) {
+	app, _ := Setup(t)
+
+	for acc := range maccPerms {
+		addr := authtypes.NewModuleAddress(acc)
+		require.Equal(t, acc != govtypes.ModuleName, app.BankKeeper.BlockedAddr(addr), acc)
+	}
+}
+
+func TestExampleAppKudosWiring(t *testing.T) {
+	app, addr := Setup(t)
+
+	// Every kudos Msg is routed to the module
+	for _, msg := range []sdk.Msg{
+		&kudostypes.MsgSendKudos{},
+		&kudostypes.MsgMultiSendKudos{},
+		&kudostypes.MsgRevokeKudos{},
+		&kudostypes.MsgUpdateParams{},
+		&kudostypes.MsgSetCategory{},
+		&kudostypes.MsgSetBudgetAllowance{},
+		&kudostypes.MsgFundRewardPool{},
+		&kudostypes.MsgRedeemKudos{},
+	} {
+		require.NotNil(t, app.MsgServiceRouter().Handler(msg), sdk.MsgTypeURL(msg))
+	}
+
+	// The module is governed by x/gov and was initialized from the default genesis
+	ctx := app.NewContext(true)
+	require.Equal(t, authtypes.NewModuleAddress(govtypes.ModuleName).String(), app.KudosKeeper.GetAuthority())
+	require.Equal(t, kudostypes.DefaultParams(), app.KudosKeeper.GetParams(ctx))
+
+	// Kudos can be sent between accounts of the app
+	to := sdk.AccAddress("kudos-recipient-----")
+	require.NoError(t, app.KudosKeeper.SendKudos(ctx, addr.String(), to.String(), 5, "welcome", 0, nil))
+	require.Equal(t, uint64(5), app.KudosKeeper.GetKudosBalance(ctx, to.String()))
+}
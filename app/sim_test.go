@@ -0,0 +1,83 @@
+package app
+
+import (
+	"os"
+	"testing"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+	simcli "github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
+	"github.com/stretchr/testify/require"
+
+	kudoskeeper "github.com/pavlenkotm/cosmos-kudos-module/x/kudos/keeper"
+)
+
+// SimAppChainID is the chain ID used by the simulations
+const SimAppChainID = "simulation-app"
+
+func init() {
+	simcli.GetSimulatorFlags()
+}
+
+// TestFullAppSimulation runs randomized blocks of transactions from random accounts against the
+// full app. Without -Enabled a short simulation is run so that a plain `go test ./app` still
+// exercises every module, with x/crisis checking the invariants after every block; with it the
+// -NumBlocks, -BlockSize, -Period, -Seed and other simulator flags apply. The kudos invariants
+// are asserted once more on the final state.
+func TestFullAppSimulation(t *testing.T) {
+	config := simcli.NewConfigFromFlags()
+	config.ChainID = SimAppChainID
+	config.Commit = true
+	invCheckPeriod := simcli.FlagPeriodValue
+	if !simcli.FlagEnabledValue {
+		config.NumBlocks = 20
+		config.BlockSize = 50
+		config.DBBackend = "memdb"
+		invCheckPeriod = 1
+	}
+
+	db, dir, logger, _, err := simtestutil.SetupSimulation(config, "leveldb-app-sim", "Simulation", simcli.FlagVerboseValue, true)
+	require.NoError(t, err, "simulation setup failed")
+
+	defer func() {
+		require.NoError(t, db.Close())
+		require.NoError(t, os.RemoveAll(dir))
+	}()
+
+	appOptions := make(simtestutil.AppOptionsMap, 0)
+	appOptions[flags.FlagHome] = DefaultNodeHome
+	appOptions[server.FlagInvCheckPeriod] = invCheckPeriod
+
+	app := NewExampleApp(logger, db, nil, true, appOptions, baseapp.SetChainID(SimAppChainID))
+	require.Equal(t, appName, app.Name())
+
+	// run randomized simulation
+	_, simParams, simErr := simulation.SimulateFromSeed(
+		t,
+		os.Stdout,
+		app.BaseApp,
+		simtestutil.AppStateFn(app.AppCodec(), app.SimulationManager(), app.DefaultGenesis()),
+		simtypes.RandomAccounts,
+		simtestutil.SimulationOperations(app, app.AppCodec(), config),
+		BlockedAddresses(),
+		config,
+		app.AppCodec(),
+	)
+
+	// export state and simParams before the simulation error is checked
+	err = simtestutil.CheckExportSimulation(app, config, simParams)
+	require.NoError(t, err)
+	require.NoError(t, simErr)
+
+	ctx := app.NewUncachedContext(false, cmtproto.Header{Height: app.LastBlockHeight()})
+	require.NoError(t, kudoskeeper.AssertInvariants(ctx, app.KudosKeeper))
+
+	if config.Commit {
+		simtestutil.PrintStats(db)
+	}
+}
@@ -0,0 +1,62 @@
+package cmd_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pavlenkotm/cosmos-kudos-module/app"
+	"github.com/pavlenkotm/cosmos-kudos-module/cmd/kudosd/cmd"
+)
+
+func TestInitCmd(t *testing.T) {
+	home := t.TempDir()
+
+	rootCmd := cmd.NewRootCmd()
+	rootCmd.SetArgs([]string{"init", "local", "--home", home, "--chain-id", "kudos-local"})
+	require.NoError(t, svrcmd.Execute(rootCmd, "", app.DefaultNodeHome))
+
+	genesis, err := os.ReadFile(filepath.Join(home, "config", "genesis.json"))
+	require.NoError(t, err)
+	require.Contains(t, string(genesis), `"kudos"`)
+}
+
+func TestRootCmdModuleCommands(t *testing.T) {
+	rootCmd := cmd.NewRootCmd()
+
+	for _, args := range [][]string{
+		{"tx", "kudos", "send"},
+		{"tx", "kudos", "multi-send"},
+		{"tx", "kudos", "redeem"},
+		{"query", "kudos", "balance"},
+		{"query", "kudos", "leaderboard"},
+		{"query", "kudos", "params"},
+		{"genesis", "add-genesis-account"},
+		{"genesis", "collect-gentxs"},
+		{"start"},
+		{"export"},
+	} {
+		found, _, err := rootCmd.Find(args)
+		require.NoError(t, err, args)
+		require.Equal(t, args[len(args)-1], found.Name())
+	}
+}
+
+func TestAutoCLIFlags(t *testing.T) {
+	rootCmd := cmd.NewRootCmd()
+
+	send, _, err := rootCmd.Find([]string{"tx", "kudos", "send"})
+	require.NoError(t, err)
+	for _, flag := range []string{"comment", "category", "kudos-tip", "tip", "from"} {
+		require.NotNil(t, send.Flags().Lookup(flag), flag)
+	}
+
+	history, _, err := rootCmd.Find([]string{"query", "kudos", "history"})
+	require.NoError(t, err)
+	for _, flag := range []string{"category", "page-limit", "page-reverse"} {
+		require.NotNil(t, history.Flags().Lookup(flag), flag)
+	}
+}
@@ -0,0 +1,223 @@
+package kudos
+
+import (
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	kudosapi "github.com/pavlenkotm/cosmos-kudos-module/api/kudos"
+)
+
+// AutoCLIOptions implements the autocli.HasAutoCLIConfig interface. The commands returned by
+// GetQueryCmd and GetTxCmd are enhanced with the commands generated here.
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service:              kudosapi.Query_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod:      "KudosBalance",
+					Use:            "balance [address]",
+					Short:          "Query kudos balance for an address",
+					Long:           "Query the current kudos balance of an address together with all kudos it ever received.",
+					Example:        "kudos balance cosmos1...",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "KudosLeaderboard",
+					Skip:      true, // served by the custom leaderboard command together with PeriodLeaderboard
+				},
+				{
+					RpcMethod:      "KudosDailyQuota",
+					Use:            "quota [address]",
+					Short:          "Query daily kudos sending quota for an address",
+					Long:           "Check how many kudos an address can still send in the current quota window.",
+					Example:        "kudos quota cosmos1...",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the current kudos module parameters",
+				},
+				{
+					RpcMethod:   "KudosHistory",
+					Use:         "history",
+					Short:       "Query the global kudos history feed",
+					Long:        "Query all kudos transfers, newest first. Use --page-reverse to list the oldest first.",
+					Example:     "kudos history --page-limit 20",
+					FlagOptions: map[string]*autocliv1.FlagOptions{"category_id": categoryFlag},
+				},
+				{
+					RpcMethod:      "KudosSent",
+					Use:            "sent [address]",
+					Short:          "Query kudos sent by an address",
+					Long:           "Query the kudos transfers sent by an address, newest first.",
+					Example:        "kudos sent cosmos1... --page-limit 20",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+					FlagOptions:    map[string]*autocliv1.FlagOptions{"category_id": categoryFlag},
+				},
+				{
+					RpcMethod:      "KudosReceived",
+					Use:            "received [address]",
+					Short:          "Query kudos received by an address",
+					Long:           "Query the kudos transfers received by an address, newest first.",
+					Example:        "kudos received cosmos1... --page-limit 20",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+					FlagOptions:    map[string]*autocliv1.FlagOptions{"category_id": categoryFlag},
+				},
+				{
+					RpcMethod: "GiverLeaderboard",
+					Use:       "givers [limit]",
+					Short:     "Query top kudos givers",
+					Long: "Query the leaderboard of addresses that have sent the most kudos over their lifetime. " +
+						"When no limit is given the default leaderboard limit from module params is used.",
+					Example:        "kudos givers 10",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "limit", Optional: true}},
+				},
+				{
+					RpcMethod: "PeriodLeaderboard",
+					Use:       "period-leaderboard [period]",
+					Short:     "Query the kudos leaderboard of a week, a month or a custom window",
+					Long: "Rank the kudos received within the last week or month, or within --start-time and " +
+						"--end-time (unix seconds) when no period is given; windows have day granularity. " +
+						"The leaderboard command accepts the same windows as RFC3339 timestamps.",
+					Example:        "kudos period-leaderboard week --limit 10",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "period", Optional: true}},
+				},
+				{
+					RpcMethod: "Categories",
+					Use:       "categories",
+					Short:     "List kudos categories",
+				},
+				{
+					RpcMethod: "CategoryLeaderboard",
+					Use:       "category-leaderboard [category-id] [limit]",
+					Short:     "Query the kudos leaderboard of a category",
+					Long: "Query the top receivers of kudos sent within a category. " +
+						"When no limit is given the default leaderboard limit from module params is used.",
+					Example: "kudos category-leaderboard 1 10",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "category_id"},
+						{ProtoField: "limit", Optional: true},
+					},
+				},
+				{
+					RpcMethod:      "CategoryBalances",
+					Use:            "category-balances [address]",
+					Short:          "Query the kudos an address received per category",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "Season",
+					Use:            "season [season-id]",
+					Short:          "Query the running season, or an ended season by id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "season_id", Optional: true}},
+				},
+				{
+					RpcMethod:      "SeasonStandings",
+					Use:            "season-standings [season-id]",
+					Short:          "Query the final leaderboard of an ended season",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "season_id"}},
+				},
+				{
+					RpcMethod: "GiveableBalance",
+					Use:       "giveable [address]",
+					Short:     "Query the giveable kudos allowance an address has left",
+					Long: "Check how many kudos an address can still give in the current budget epoch. This is " +
+						"separate from the kudos it has received and only applies when budget mode is enabled.",
+					Example:        "kudos giveable cosmos1...",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "RewardPool",
+					Use:       "reward-pool",
+					Short:     "Query the coins held by the reward pool",
+				},
+				{
+					RpcMethod:      "Redemptions",
+					Use:            "redemptions [address]",
+					Short:          "Query the kudos an address redeemed for reward pool coins",
+					Long:           "Query the redemption history of an address, newest first.",
+					Example:        "kudos redemptions cosmos1... --page-limit 20",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              kudosapi.Msg_ServiceDesc.ServiceName,
+			EnhanceCustomCommand: true,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "SendKudos",
+					Use:       "send [to_address] [amount]",
+					Short:     "Send kudos to another address",
+					Long: "Send kudos to another address with an optional comment. --kudos-tip attaches coins that are " +
+						"transferred to the recipient together with the kudos; their denoms must be allowed by module params.",
+					Example: `kudos send cosmos1... 10 --comment "Thanks for the code review!" --category 1
+kudos send cosmos1... 10 --kudos-tip 5000000stake`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "to_address"}, {ProtoField: "amount"}},
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"comment":     {Usage: "Comment for the kudos (max length set by module params)"},
+						"category_id": {Name: "category", Usage: "Optional category ID of the kudos"},
+						// not named "tip" as that is already the SDK tx flag for auxiliary fee tips
+						"tip": {Name: "kudos-tip", Usage: "Optional coins sent to the recipient with the kudos, e.g. 5000000stake"},
+					},
+				},
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "MultiSendKudos",
+					Skip:      true, // served by the custom multi-send command, which also reads recipient files
+				},
+				{
+					RpcMethod: "SetCategory",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "RevokeKudos",
+					Use:       "revoke [history_id]",
+					Short:     "Revoke kudos you sent within the grace period",
+					Long: "Revoke a kudos transfer you sent, identified by its history ID. Transfers can only be " +
+						"revoked within the grace period set by module params.",
+					Example:        "kudos revoke 42",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "history_id"}},
+				},
+				{
+					RpcMethod: "SetBudgetAllowance",
+					Use:       "set-budget-allowance [address] [allowance]",
+					Short:     "Override the giveable kudos allowance an address receives each budget epoch",
+					Long: "Override the giveable kudos allowance an address receives each budget epoch. Only the " +
+						"budget admin set in module params may sign this directly; the module authority submits it " +
+						"through a governance proposal. An allowance already refilled for the current epoch is kept; " +
+						"the override applies from the next refill. --remove drops the override so the address falls " +
+						"back to the params default.",
+					Example: `kudos set-budget-allowance cosmos1... 250 --from admin
+kudos set-budget-allowance cosmos1... 0 --remove --from admin`,
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}, {ProtoField: "allowance"}},
+				},
+				{
+					RpcMethod:      "FundRewardPool",
+					Use:            "fund-reward-pool [amount]",
+					Short:          "Deposit coins into the reward pool that pays out redeemed kudos",
+					Long:           "Deposit coins into the kudos reward pool. Anyone can fund the pool, but only in the redeem denom set by module params.",
+					Example:        "kudos fund-reward-pool 1000000stake",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount", Varargs: true}},
+				},
+				{
+					RpcMethod:      "RedeemKudos",
+					Use:            "redeem [amount]",
+					Short:          "Redeem received kudos for coins from the reward pool",
+					Long:           "Burn kudos from your balance in exchange for coins from the reward pool, at the redeem rate set by module params.",
+					Example:        "kudos redeem 25",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+			},
+		},
+	}
+}
+
+// categoryFlag keeps the category_id filter of the history queries behind the --category flag
+// the module's commands have always used
+var categoryFlag = &autocliv1.FlagOptions{Name: "category", Usage: "Only return kudos of this category"}
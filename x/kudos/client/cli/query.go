@@ -4,16 +4,25 @@ import (
 	"context"
 	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
 )
 
-// GetQueryCmd returns the cli query commands for this module
+const (
+	FlagPeriod = "period"
+	FlagStart  = "start"
+	FlagEnd    = "end"
+)
+
+// GetQueryCmd returns the cli query commands for this module that need custom behavior; the
+// commands for the remaining Query RPCs are generated by AutoCLI from AppModule.AutoCLIOptions
 func GetQueryCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        types.ModuleName,
@@ -24,25 +33,30 @@ func GetQueryCmd() *cobra.Command {
 	}
 
 	cmd.AddCommand(
-		CmdQueryBalance(),
 		CmdQueryLeaderboard(),
-		CmdQueryDailyQuota(),
 	)
 
 	return cmd
 }
 
-// CmdQueryBalance returns a CLI command handler for querying kudos balance
-func CmdQueryBalance() *cobra.Command {
+// CmdQueryLeaderboard returns a CLI command handler for querying the kudos leaderboard
+func CmdQueryLeaderboard() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "balance [address]",
-		Short: "Query kudos balance for an address",
-		Long: `Query the kudos balance for a specific address.
+		Use:   "leaderboard [limit]",
+		Short: "Query kudos leaderboard",
+		Long: `Query the kudos leaderboard showing top receivers with their rank.
+When no limit is given the default leaderboard limit from module params is used.
+
+With --period (week or month) or --start/--end (RFC3339) only kudos received within
+that window are ranked; windows have day granularity.
 
 Example:
-  kudos balance cosmos1...
+  kudos leaderboard 10
+  kudos leaderboard 10 --page 2
+  kudos leaderboard 10 --period week
+  kudos leaderboard --start 2024-01-01T00:00:00Z --end 2024-04-01T00:00:00Z
 `,
-		Args: cobra.ExactArgs(1),
+		Args: cobra.MaximumNArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
 			if err != nil {
@@ -51,54 +65,30 @@ Example:
 
 			queryClient := types.NewQueryClient(clientCtx)
 
-			params := &types.QueryKudosBalanceRequest{
-				Address: args[0],
-			}
-
-			res, err := queryClient.KudosBalance(context.Background(), params)
+			limit, pageReq, err := readLeaderboardPage(cmd, args)
 			if err != nil {
 				return err
 			}
 
-			return clientCtx.PrintProto(res)
-		},
-	}
-
-	flags.AddQueryFlagsToCmd(cmd)
-
-	return cmd
-}
-
-// CmdQueryLeaderboard returns a CLI command handler for querying the kudos leaderboard
-func CmdQueryLeaderboard() *cobra.Command {
-	cmd := &cobra.Command{
-		Use:   "leaderboard [limit]",
-		Short: "Query kudos leaderboard",
-		Long: `Query the kudos leaderboard showing top receivers.
-
-Example:
-  kudos leaderboard 10
-`,
-		Args: cobra.MaximumNArgs(1),
-		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx, err := client.GetClientQueryContext(cmd)
+			periodReq, err := readPeriodFlags(cmd)
 			if err != nil {
 				return err
 			}
+			if periodReq != nil {
+				periodReq.Limit = limit
+				periodReq.Pagination = pageReq
 
-			queryClient := types.NewQueryClient(clientCtx)
-
-			limit := uint32(10) // default limit
-			if len(args) > 0 {
-				parsedLimit, err := strconv.ParseUint(args[0], 10, 32)
+				res, err := queryClient.PeriodLeaderboard(context.Background(), periodReq)
 				if err != nil {
-					return fmt.Errorf("invalid limit: %w", err)
+					return err
 				}
-				limit = uint32(parsedLimit)
+
+				return clientCtx.PrintProto(res)
 			}
 
 			params := &types.QueryKudosLeaderboardRequest{
-				Limit: limit,
+				Limit:      limit,
+				Pagination: pageReq,
 			}
 
 			res, err := queryClient.KudosLeaderboard(context.Background(), params)
@@ -110,44 +100,77 @@ Example:
 		},
 	}
 
+	cmd.Flags().String(FlagPeriod, "", "Rank only kudos received within the last week or month")
+	cmd.Flags().String(FlagStart, "", "Start of the ranking window (RFC3339, inclusive)")
+	cmd.Flags().String(FlagEnd, "", "End of the ranking window (RFC3339, exclusive); defaults to now")
 	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "leaderboard")
 
 	return cmd
 }
 
-// CmdQueryDailyQuota returns a CLI command handler for querying the daily quota state
-func CmdQueryDailyQuota() *cobra.Command {
-	cmd := &cobra.Command{
-		Use:   "quota [address]",
-		Short: "Query daily kudos sending quota for an address",
-		Long: `Check how many kudos an address can still send in the current 24h window.
-
-Example:
-  kudos quota cosmos1...
-`,
-		Args: cobra.ExactArgs(1),
-		RunE: func(cmd *cobra.Command, args []string) error {
-			clientCtx, err := client.GetClientQueryContext(cmd)
-			if err != nil {
-				return err
-			}
-
-			queryClient := types.NewQueryClient(clientCtx)
+// readLeaderboardPage parses the optional positional limit and pagination flags shared by leaderboard commands
+func readLeaderboardPage(cmd *cobra.Command, args []string) (uint32, *query.PageRequest, error) {
+	var limit uint32 // 0 lets the node apply the default limit
+	if len(args) > 0 {
+		parsedLimit, err := strconv.ParseUint(args[0], 10, 32)
+		if err != nil {
+			return 0, nil, fmt.Errorf("invalid limit: %w", err)
+		}
+		limit = uint32(parsedLimit)
+	}
 
-			params := &types.QueryDailyQuotaRequest{
-				Address: args[0],
-			}
+	pageReq, err := client.ReadPageRequest(cmd.Flags())
+	if err != nil {
+		return 0, nil, err
+	}
+	// The positional limit wins over --limit; without either let the node apply its default
+	if len(args) > 0 {
+		pageReq.Limit = uint64(limit)
+		if page, _ := cmd.Flags().GetUint64(flags.FlagPage); page > 1 {
+			pageReq.Offset = (page - 1) * pageReq.Limit
+		}
+	} else if !cmd.Flags().Changed(flags.FlagLimit) {
+		pageReq.Limit = 0
+	}
 
-			res, err := queryClient.KudosDailyQuota(context.Background(), params)
-			if err != nil {
-				return err
-			}
+	return limit, pageReq, nil
+}
 
-			return clientCtx.PrintProto(res)
-		},
+// readPeriodFlags builds a windowed leaderboard request from the period flags, or returns nil
+// when none are set
+func readPeriodFlags(cmd *cobra.Command) (*types.QueryPeriodLeaderboardRequest, error) {
+	period, err := cmd.Flags().GetString(FlagPeriod)
+	if err != nil {
+		return nil, err
+	}
+	start, err := cmd.Flags().GetString(FlagStart)
+	if err != nil {
+		return nil, err
+	}
+	end, err := cmd.Flags().GetString(FlagEnd)
+	if err != nil {
+		return nil, err
+	}
+	if period == "" && start == "" && end == "" {
+		return nil, nil
 	}
 
-	flags.AddQueryFlagsToCmd(cmd)
+	req := &types.QueryPeriodLeaderboardRequest{Period: period}
+	if start != "" {
+		startTime, err := time.Parse(time.RFC3339, start)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s: %w", FlagStart, err)
+		}
+		req.StartTime = startTime.Unix()
+	}
+	if end != "" {
+		endTime, err := time.Parse(time.RFC3339, end)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s: %w", FlagEnd, err)
+		}
+		req.EndTime = endTime.Unix()
+	}
 
-	return cmd
+	return req, nil
 }
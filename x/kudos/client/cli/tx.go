@@ -1,23 +1,39 @@
 package cli
 
 import (
+	"encoding/csv"
+	"encoding/json"
 	"fmt"
+	"os"
+	"path/filepath"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 
 	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
 )
 
 const (
-	FlagComment = "comment"
+	FlagTo       = "to"
+	FlagFile     = "file"
+	FlagCategory = "category"
+
+	FlagSpendLimit = "spend-limit"
+	FlagAllowList  = "allow-list"
+	FlagExpiration = "expiration"
+	FlagGeneric    = "generic"
 )
 
-// GetTxCmd returns the transaction commands for this module
+// GetTxCmd returns the transaction commands for this module that need custom behavior; the
+// commands for the remaining Msg RPCs are generated by AutoCLI from AppModule.AutoCLIOptions
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:                        types.ModuleName,
@@ -27,49 +43,71 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
-	cmd.AddCommand(CmdSendKudos())
+	cmd.AddCommand(
+		CmdMultiSendKudos(),
+		CmdGrantSendKudos(),
+		CmdRevokeSendKudos(),
+	)
 
 	return cmd
 }
 
-// CmdSendKudos returns a CLI command handler for sending kudos
-func CmdSendKudos() *cobra.Command {
+// CmdMultiSendKudos returns a CLI command handler for sending kudos to several recipients at once
+func CmdMultiSendKudos() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "send [to_address] [amount]",
-		Short: "Send kudos to another address",
-		Long: `Send kudos to another address with an optional comment.
+		Use:   "multi-send",
+		Short: "Send kudos to several addresses in one transaction",
+		Long: `Send kudos to several addresses atomically. Recipients are given with repeated
+--to address:amount[:comment] flags or a --file. A .csv file holds address,amount[,comment]
+rows (an optional to_address,amount,comment header is skipped); a .json file holds a list
+of {"to_address", "amount", "comment", "category_id"} objects. --category applies to every
+output without its own category. The total counts once against the daily quota.
 
 Example:
-  kudos send cosmos1... 10 --comment "Thanks for the code review!"
+  kudos multi-send --to "cosmos1...:5:Great retro" --to cosmos1...:3
+  kudos multi-send --file retro.csv
 `,
-		Args: cobra.ExactArgs(2),
+		Args: cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			toAddress := args[0]
-			amount, err := strconv.ParseUint(args[1], 10, 64)
+			recipients, err := cmd.Flags().GetStringArray(FlagTo)
 			if err != nil {
-				return fmt.Errorf("invalid amount: %w", err)
+				return err
+			}
+			file, err := cmd.Flags().GetString(FlagFile)
+			if err != nil {
+				return err
 			}
 
-			comment, err := cmd.Flags().GetString(FlagComment)
+			outputs, err := parseOutputFlags(recipients)
 			if err != nil {
 				return err
 			}
+			if file != "" {
+				fileOutputs, err := readOutputsFile(file)
+				if err != nil {
+					return err
+				}
+				outputs = append(outputs, fileOutputs...)
+			}
 
-			// Validate comment length
-			if len(comment) > 140 {
-				return fmt.Errorf("comment exceeds 140 characters")
+			categoryID, err := cmd.Flags().GetUint64(FlagCategory)
+			if err != nil {
+				return err
+			}
+			for i := range outputs {
+				if outputs[i].CategoryId == 0 {
+					outputs[i].CategoryId = categoryID
+				}
 			}
 
-			msg := &types.MsgSendKudos{
+			msg := &types.MsgMultiSendKudos{
 				FromAddress: clientCtx.GetFromAddress().String(),
-				ToAddress:   toAddress,
-				Amount:      amount,
-				Comment:     comment,
+				Outputs:     outputs,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -80,7 +118,221 @@ Example:
 		},
 	}
 
-	cmd.Flags().String(FlagComment, "", "Comment for the kudos (max 140 characters)")
+	cmd.Flags().StringArray(FlagTo, nil, "Recipient as address:amount[:comment]; may be repeated")
+	cmd.Flags().String(FlagFile, "", "CSV or JSON file listing the recipients")
+	cmd.Flags().Uint64(FlagCategory, 0, "Category ID for outputs that do not set one")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseOutputFlags parses address:amount[:comment] recipients; the comment may itself contain colons
+func parseOutputFlags(recipients []string) ([]types.KudosOutput, error) {
+	outputs := make([]types.KudosOutput, 0, len(recipients))
+	for _, recipient := range recipients {
+		parts := strings.SplitN(recipient, ":", 3)
+		if len(parts) < 2 {
+			return nil, fmt.Errorf("invalid --%s %q, expected address:amount[:comment]", FlagTo, recipient)
+		}
+
+		output, err := newOutput(parts[0], parts[1], parts[2:]...)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --%s %q: %w", FlagTo, recipient, err)
+		}
+		outputs = append(outputs, output)
+	}
+
+	return outputs, nil
+}
+
+// readOutputsFile reads recipients from a .csv or .json file
+func readOutputsFile(path string) ([]types.KudosOutput, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		var outputs []types.KudosOutput
+		if err := json.Unmarshal(bz, &outputs); err != nil {
+			return nil, fmt.Errorf("invalid recipients file %s: %w", path, err)
+		}
+		return outputs, nil
+
+	case ".csv":
+		reader := csv.NewReader(strings.NewReader(string(bz)))
+		reader.FieldsPerRecord = -1
+		reader.TrimLeadingSpace = true
+
+		records, err := reader.ReadAll()
+		if err != nil {
+			return nil, fmt.Errorf("invalid recipients file %s: %w", path, err)
+		}
+		if len(records) > 0 && strings.EqualFold(records[0][0], "to_address") {
+			records = records[1:]
+		}
+
+		outputs := make([]types.KudosOutput, 0, len(records))
+		for i, record := range records {
+			if len(record) < 2 || len(record) > 3 {
+				return nil, fmt.Errorf("invalid recipients file %s: row %d must be address,amount[,comment]", path, i+1)
+			}
+			output, err := newOutput(record[0], record[1], record[2:]...)
+			if err != nil {
+				return nil, fmt.Errorf("invalid recipients file %s: row %d: %w", path, i+1, err)
+			}
+			outputs = append(outputs, output)
+		}
+		return outputs, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported recipients file %s, expected .csv or .json", path)
+	}
+}
+
+// newOutput builds a KudosOutput from its textual address, amount and optional comment
+func newOutput(toAddress, amount string, comment ...string) (types.KudosOutput, error) {
+	parsedAmount, err := strconv.ParseUint(strings.TrimSpace(amount), 10, 64)
+	if err != nil {
+		return types.KudosOutput{}, fmt.Errorf("invalid amount: %w", err)
+	}
+
+	output := types.KudosOutput{
+		ToAddress: strings.TrimSpace(toAddress),
+		Amount:    parsedAmount,
+	}
+	if len(comment) > 0 {
+		output.Comment = comment[0]
+	}
+
+	return output, nil
+}
+
+// CmdGrantSendKudos returns a CLI command handler for letting another account send kudos on your behalf
+func CmdGrantSendKudos() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "grant-send [grantee]",
+		Short: "Allow another account to send kudos on your behalf through x/authz",
+		Long: `Grant another account, such as a bot, permission to send kudos on your behalf. By default
+a SendKudosAuthorization is granted: it caps the total kudos the grantee may send (--spend-limit),
+optionally restricts the recipients (--allow-list) and expires at --expiration. Kudos with a tip
+are never accepted under it. --generic grants an unrestricted GenericAuthorization for MsgSendKudos
+instead. The grantee sends with "tx authz exec".
+
+Example:
+  kudos grant-send cosmos1bot... --spend-limit 500 --from lead
+  kudos grant-send cosmos1bot... --spend-limit 50 --allow-list cosmos1a...,cosmos1b... --expiration 1735689600 --from lead
+  kudos grant-send cosmos1bot... --generic --from lead
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid grantee address: %w", err)
+			}
+			if grantee.Equals(clientCtx.GetFromAddress()) {
+				return fmt.Errorf("grantee and granter must be different")
+			}
+
+			spendLimit, err := cmd.Flags().GetUint64(FlagSpendLimit)
+			if err != nil {
+				return err
+			}
+			allowList, err := cmd.Flags().GetStringSlice(FlagAllowList)
+			if err != nil {
+				return err
+			}
+			expiration, err := cmd.Flags().GetInt64(FlagExpiration)
+			if err != nil {
+				return err
+			}
+			generic, err := cmd.Flags().GetBool(FlagGeneric)
+			if err != nil {
+				return err
+			}
+
+			authorization, err := newSendKudosGrant(spendLimit, allowList, expiration, generic)
+			if err != nil {
+				return err
+			}
+
+			// The grant expires together with the authorization so that x/authz prunes it
+			var grantExpiration *time.Time
+			if expiration != 0 {
+				t := time.Unix(expiration, 0)
+				grantExpiration = &t
+			}
+
+			msg, err := authz.NewMsgGrant(clientCtx.GetFromAddress(), grantee, authorization, grantExpiration)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(FlagSpendLimit, 0, "Total kudos the grantee may send on your behalf")
+	cmd.Flags().StringSlice(FlagAllowList, nil, "Comma separated recipients the grantee may send to; any recipient when empty")
+	cmd.Flags().Int64(FlagExpiration, 0, "Unix time from which the grant is no longer valid; 0 for no expiry")
+	cmd.Flags().Bool(FlagGeneric, false, "Grant an unrestricted GenericAuthorization for MsgSendKudos")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// newSendKudosGrant builds the authorization granted by grant-send
+func newSendKudosGrant(spendLimit uint64, allowList []string, expiration int64, generic bool) (authz.Authorization, error) {
+	if generic {
+		if spendLimit != 0 || len(allowList) > 0 {
+			return nil, fmt.Errorf("--%s and --%s cannot be used with --%s", FlagSpendLimit, FlagAllowList, FlagGeneric)
+		}
+		return authz.NewGenericAuthorization(sdk.MsgTypeURL(&types.MsgSendKudos{})), nil
+	}
+
+	authorization := types.NewSendKudosAuthorization(spendLimit, allowList, expiration)
+	if err := authorization.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	return authorization, nil
+}
+
+// CmdRevokeSendKudos returns a CLI command handler for withdrawing a kudos send grant
+func CmdRevokeSendKudos() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke-send [grantee]",
+		Short: "Withdraw the permission of another account to send kudos on your behalf",
+		Long: `Revoke the x/authz grant for MsgSendKudos given to grantee, whether it is a
+SendKudosAuthorization or a GenericAuthorization.
+
+Example:
+  kudos revoke-send cosmos1bot... --from lead
+`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			grantee, err := sdk.AccAddressFromBech32(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid grantee address: %w", err)
+			}
+
+			msg := authz.NewMsgRevoke(clientCtx.GetFromAddress(), grantee, sdk.MsgTypeURL(&types.MsgSendKudos{}))
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
 	flags.AddTxFlagsToCmd(cmd)
 
 	return cmd
@@ -0,0 +1,71 @@
+package cli
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+func TestParseOutputFlags(t *testing.T) {
+	outputs, err := parseOutputFlags([]string{"cosmos1bob:5:great: retro", "cosmos1carol:3"})
+	require.NoError(t, err)
+	require.Equal(t, []types.KudosOutput{
+		{ToAddress: "cosmos1bob", Amount: 5, Comment: "great: retro"},
+		{ToAddress: "cosmos1carol", Amount: 3},
+	}, outputs)
+
+	_, err = parseOutputFlags([]string{"cosmos1bob"})
+	require.Error(t, err)
+
+	_, err = parseOutputFlags([]string{"cosmos1bob:many"})
+	require.Error(t, err)
+}
+
+func TestReadOutputsFile(t *testing.T) {
+	dir := t.TempDir()
+	expected := []types.KudosOutput{
+		{ToAddress: "cosmos1bob", Amount: 5, Comment: "code review, again"},
+		{ToAddress: "cosmos1carol", Amount: 3},
+	}
+
+	csvPath := filepath.Join(dir, "retro.csv")
+	require.NoError(t, os.WriteFile(csvPath, []byte("to_address,amount,comment\ncosmos1bob,5,\"code review, again\"\ncosmos1carol,3\n"), 0o600))
+	outputs, err := readOutputsFile(csvPath)
+	require.NoError(t, err)
+	require.Equal(t, expected, outputs)
+
+	jsonPath := filepath.Join(dir, "retro.json")
+	require.NoError(t, os.WriteFile(jsonPath, []byte(`[{"to_address":"cosmos1bob","amount":5,"comment":"code review, again"},{"to_address":"cosmos1carol","amount":3}]`), 0o600))
+	outputs, err = readOutputsFile(jsonPath)
+	require.NoError(t, err)
+	require.Equal(t, expected, outputs)
+
+	txtPath := filepath.Join(dir, "retro.txt")
+	require.NoError(t, os.WriteFile(txtPath, []byte("cosmos1bob,5"), 0o600))
+	_, err = readOutputsFile(txtPath)
+	require.Error(t, err)
+}
+
+func TestNewSendKudosGrant(t *testing.T) {
+	recipient := sdk.AccAddress([]byte("recipient___________")).String()
+
+	grant, err := newSendKudosGrant(50, []string{recipient}, 1700000000, false)
+	require.NoError(t, err)
+	require.Equal(t, types.NewSendKudosAuthorization(50, []string{recipient}, 1700000000), grant)
+
+	grant, err = newSendKudosGrant(0, nil, 0, true)
+	require.NoError(t, err)
+	require.Equal(t, authz.NewGenericAuthorization("/kudos.MsgSendKudos"), grant)
+
+	// A spend limit is required unless the grant is generic, and makes no sense with it
+	_, err = newSendKudosGrant(0, nil, 0, false)
+	require.Error(t, err)
+	_, err = newSendKudosGrant(50, nil, 0, true)
+	require.Error(t, err)
+}
@@ -0,0 +1,224 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+// GetBudgetAllowance returns the per-epoch allowance override of an address, if one is set
+func (k Keeper) GetBudgetAllowance(ctx sdk.Context, address string) (uint64, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	bz, err := store.Get(types.BudgetAllowanceKey(address))
+	if err != nil || bz == nil {
+		return 0, false
+	}
+
+	return binary.BigEndian.Uint64(bz), true
+}
+
+// SetBudgetAllowance overrides the allowance an address receives each budget epoch
+func (k Keeper) SetBudgetAllowance(ctx sdk.Context, address string, allowance uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, allowance)
+
+	if err := store.Set(types.BudgetAllowanceKey(address), bz); err != nil {
+		panic(err)
+	}
+}
+
+// DeleteBudgetAllowance removes the allowance override of an address so params.budget_per_epoch applies again
+func (k Keeper) DeleteBudgetAllowance(ctx sdk.Context, address string) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	if err := store.Delete(types.BudgetAllowanceKey(address)); err != nil {
+		panic(err)
+	}
+}
+
+// IterateBudgetAllowances iterates over all allowance overrides in address order until cb returns true
+func (k Keeper) IterateBudgetAllowances(ctx sdk.Context, cb func(address string, allowance uint64) (stop bool)) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	iterator, err := store.Iterator(types.BudgetAllowancePrefix, storetypes.PrefixEndBytes(types.BudgetAllowancePrefix))
+	if err != nil {
+		panic(err)
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		address := string(iterator.Key()[len(types.BudgetAllowancePrefix):])
+		if cb(address, binary.BigEndian.Uint64(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// GetGiveableBalance returns the stored giveable allowance of an address as of the epoch it was last
+// touched in; use GetGiveable for the allowance refilled to the current epoch
+func (k Keeper) GetGiveableBalance(ctx sdk.Context, address string) (types.GiveableBalance, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	bz, err := store.Get(types.GiveableBalanceKey(address))
+	if err != nil || bz == nil {
+		return types.GiveableBalance{}, false
+	}
+
+	return types.GiveableBalance{
+		Address:   address,
+		Remaining: binary.BigEndian.Uint64(bz[:8]),
+		Epoch:     binary.BigEndian.Uint64(bz[8:]),
+	}, true
+}
+
+// SetGiveableBalance stores the giveable allowance an address has left within an epoch
+func (k Keeper) SetGiveableBalance(ctx sdk.Context, balance types.GiveableBalance) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	bz := make([]byte, 16)
+	binary.BigEndian.PutUint64(bz[:8], balance.Remaining)
+	binary.BigEndian.PutUint64(bz[8:], balance.Epoch)
+
+	if err := store.Set(types.GiveableBalanceKey(balance.Address), bz); err != nil {
+		panic(err)
+	}
+}
+
+// IterateGiveableBalances iterates over all stored giveable allowances in address order until cb returns true
+func (k Keeper) IterateGiveableBalances(ctx sdk.Context, cb func(balance types.GiveableBalance) (stop bool)) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	iterator, err := store.Iterator(types.GiveableBalancePrefix, storetypes.PrefixEndBytes(types.GiveableBalancePrefix))
+	if err != nil {
+		panic(err)
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		bz := iterator.Value()
+		balance := types.GiveableBalance{
+			Address:   string(iterator.Key()[len(types.GiveableBalancePrefix):]),
+			Remaining: binary.BigEndian.Uint64(bz[:8]),
+			Epoch:     binary.BigEndian.Uint64(bz[8:]),
+		}
+		if cb(balance) {
+			break
+		}
+	}
+}
+
+// GetGiveable returns the giveable allowance an address has left in the current budget epoch
+func (k Keeper) GetGiveable(ctx sdk.Context, address string) types.QueryGiveableBalanceResponse {
+	params := k.GetParams(ctx)
+	if !params.BudgetEnabled || params.BudgetEpochSeconds == 0 {
+		return types.QueryGiveableBalanceResponse{}
+	}
+
+	epoch := budgetEpoch(ctx, params)
+
+	return types.QueryGiveableBalanceResponse{
+		BudgetEnabled: true,
+		Remaining:     k.currentGiveable(ctx, params, address, epoch),
+		Allowance:     k.epochAllowance(ctx, params, address),
+		ResetAt:       int64((epoch + 1) * params.BudgetEpochSeconds),
+	}
+}
+
+// budgetEpoch returns the index of the budget epoch the block time falls in
+func budgetEpoch(ctx sdk.Context, params types.Params) uint64 {
+	return uint64(ctx.BlockTime().Unix()) / params.BudgetEpochSeconds
+}
+
+// epochAllowance returns the allowance an address receives each epoch: its override or the params default
+func (k Keeper) epochAllowance(ctx sdk.Context, params types.Params, address string) uint64 {
+	if allowance, found := k.GetBudgetAllowance(ctx, address); found {
+		return allowance
+	}
+	return params.BudgetPerEpoch
+}
+
+// currentGiveable returns the allowance an address has left in epoch without writing the refill.
+// An address entering a new epoch receives its allowance plus up to params.budget_rollover_cap of
+// what it left unspent in the previous epoch; an address that skipped a whole epoch carries over
+// that epoch's full allowance, again capped. Addresses seen for the first time carry nothing over.
+func (k Keeper) currentGiveable(ctx sdk.Context, params types.Params, address string, epoch uint64) uint64 {
+	stored, found := k.GetGiveableBalance(ctx, address)
+	if found && stored.Epoch == epoch {
+		return stored.Remaining
+	}
+
+	allowance := k.epochAllowance(ctx, params, address)
+
+	var unspent uint64
+	switch {
+	case !found || stored.Epoch > epoch:
+		// first use, or the epoch length changed and the stored epoch no longer lines up
+	case stored.Epoch+1 == epoch:
+		unspent = stored.Remaining
+	default:
+		unspent = allowance
+	}
+
+	carry := min(unspent, params.BudgetRolloverCap)
+	if allowance+carry < allowance {
+		return ^uint64(0)
+	}
+
+	return allowance + carry
+}
+
+// checkGiveable returns an error when budget mode is enabled and amount exceeds the sender's
+// giveable allowance; it does not touch state
+func (k Keeper) checkGiveable(ctx sdk.Context, address string, amount uint64) error {
+	params := k.GetParams(ctx)
+	if !params.BudgetEnabled || params.BudgetEpochSeconds == 0 {
+		return nil
+	}
+
+	if remaining := k.currentGiveable(ctx, params, address, budgetEpoch(ctx, params)); amount > remaining {
+		return errorsmod.Wrapf(types.ErrBudgetExceeded, "address %s has %d giveable kudos left, needs %d", address, remaining, amount)
+	}
+
+	return nil
+}
+
+// spendGiveable draws amount down from the sender's giveable allowance when budget mode is
+// enabled; checkGiveable must have passed first
+func (k Keeper) spendGiveable(ctx sdk.Context, address string, amount uint64) {
+	params := k.GetParams(ctx)
+	if !params.BudgetEnabled || params.BudgetEpochSeconds == 0 {
+		return
+	}
+
+	epoch := budgetEpoch(ctx, params)
+	k.SetGiveableBalance(ctx, types.GiveableBalance{
+		Address:   address,
+		Remaining: subFloor(k.currentGiveable(ctx, params, address, epoch), amount),
+		Epoch:     epoch,
+	})
+}
+
+// refundGiveable returns amount to the sender's giveable allowance when the transfer sent at
+// timestamp was drawn from the epoch that is still running
+func (k Keeper) refundGiveable(ctx sdk.Context, address string, amount uint64, timestamp int64) {
+	params := k.GetParams(ctx)
+	if !params.BudgetEnabled || params.BudgetEpochSeconds == 0 || timestamp < 0 {
+		return
+	}
+
+	stored, found := k.GetGiveableBalance(ctx, address)
+	epoch := budgetEpoch(ctx, params)
+	if !found || stored.Epoch != epoch || uint64(timestamp)/params.BudgetEpochSeconds != epoch {
+		return
+	}
+
+	stored.Remaining += amount
+	k.SetGiveableBalance(ctx, stored)
+}
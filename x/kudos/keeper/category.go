@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+// GetCategory returns a registered category
+func (k Keeper) GetCategory(ctx sdk.Context, id uint64) (types.Category, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	bz, err := store.Get(types.CategoryKey(id))
+	if err != nil || bz == nil {
+		return types.Category{}, false
+	}
+
+	var category types.Category
+	k.cdc.MustUnmarshal(bz, &category)
+
+	return category, true
+}
+
+// SetCategory creates or updates a category in the registry
+func (k Keeper) SetCategory(ctx sdk.Context, category types.Category) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&category)
+
+	if err := store.Set(types.CategoryKey(category.Id), bz); err != nil {
+		panic(err)
+	}
+}
+
+// IterateCategories iterates over all categories in ID order until cb returns true
+func (k Keeper) IterateCategories(ctx sdk.Context, cb func(category types.Category) (stop bool)) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	iterator, err := store.Iterator(types.CategoryPrefix, storetypes.PrefixEndBytes(types.CategoryPrefix))
+	if err != nil {
+		panic(err)
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var category types.Category
+		k.cdc.MustUnmarshal(iterator.Value(), &category)
+		if cb(category) {
+			break
+		}
+	}
+}
+
+// GetCategoryBalance returns the kudos an address received within a category
+func (k Keeper) GetCategoryBalance(ctx sdk.Context, categoryID uint64, address string) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+
+	bz, err := store.Get(types.CategoryBalanceKey(categoryID, address))
+	if err != nil || bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetCategoryBalance sets the kudos an address received within a category and keeps the
+// category leaderboard index in sync
+func (k Keeper) SetCategoryBalance(ctx sdk.Context, categoryID uint64, address string, balance uint64) {
+	indexKey := func(address string, balance uint64) []byte {
+		return types.CategoryLeaderboardKey(categoryID, address, balance)
+	}
+	k.setRankedAmount(ctx, types.CategoryBalanceKey(categoryID, address), indexKey, address, balance)
+}
+
+// IterateCategoryBalances iterates over all category balances in (category, address) order until cb returns true
+func (k Keeper) IterateCategoryBalances(ctx sdk.Context, cb func(categoryID uint64, address string, balance uint64) (stop bool)) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	iterator, err := store.Iterator(types.CategoryBalancePrefix, storetypes.PrefixEndBytes(types.CategoryBalancePrefix))
+	if err != nil {
+		panic(err)
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()[len(types.CategoryBalancePrefix):]
+		if cb(binary.BigEndian.Uint64(key[:8]), string(key[8:]), binary.BigEndian.Uint64(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// GetCategoryLeaderboard returns the top N receivers within a category, ties ordered by address
+func (k Keeper) GetCategoryLeaderboard(ctx sdk.Context, categoryID uint64, limit uint32) []types.LeaderboardEntry {
+	return k.getRankedEntries(ctx, types.CategoryLeaderboardPrefixKey(categoryID), limit)
+}
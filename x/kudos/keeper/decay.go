@@ -0,0 +1,158 @@
+package keeper
+
+import (
+	"encoding/binary"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+// GetKudosLifetimeTotal returns the lifetime total of kudos received by an address, unaffected by decay
+func (k Keeper) GetKudosLifetimeTotal(ctx sdk.Context, address string) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+
+	bz, err := store.Get(types.KudosLifetimeKey(address))
+	if err != nil || bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetKudosLifetimeTotal sets the lifetime total of kudos received by an address
+func (k Keeper) SetKudosLifetimeTotal(ctx sdk.Context, address string, total uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, total)
+
+	if err := store.Set(types.KudosLifetimeKey(address), bz); err != nil {
+		panic(err)
+	}
+}
+
+// IterateKudosLifetimeTotals iterates over all lifetime received totals in address order until cb returns true
+func (k Keeper) IterateKudosLifetimeTotals(ctx sdk.Context, cb func(address string, total uint64) (stop bool)) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	iterator, err := store.Iterator(types.KudosLifetimePrefix, storetypes.PrefixEndBytes(types.KudosLifetimePrefix))
+	if err != nil {
+		panic(err)
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		address := string(iterator.Key()[len(types.KudosLifetimePrefix):])
+		if cb(address, binary.BigEndian.Uint64(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// GetDecayState returns the state of the balance decay pass
+func (k Keeper) GetDecayState(ctx sdk.Context) types.DecayState {
+	store := k.storeService.OpenKVStore(ctx)
+
+	bz, err := store.Get(types.DecayStateKey)
+	if err != nil || bz == nil {
+		return types.DecayState{}
+	}
+
+	var state types.DecayState
+	k.cdc.MustUnmarshal(bz, &state)
+
+	return state
+}
+
+// SetDecayState sets the state of the balance decay pass
+func (k Keeper) SetDecayState(ctx sdk.Context, state types.DecayState) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&state)
+
+	if err := store.Set(types.DecayStateKey, bz); err != nil {
+		panic(err)
+	}
+}
+
+// ApplyDecay runs one step of the balance decay pass and is called from the BeginBlocker.
+// The first block that sees decay enabled only records the running epoch, so balances first
+// decay at the next epoch boundary rather than right after decay is switched on. At the first
+// block of every later decay epoch a pass starts over all balances; each block then
+// reduces at most params.decay_accounts_per_block balances by params.decay_percent until the
+// pass reaches the last balance. Epochs that end before their pass completes are not
+// compounded: the next pass starts once the running one is done. No balance decays while an
+// ended season is archived. Lifetime totals, category balances and period buckets are left
+// untouched.
+func (k Keeper) ApplyDecay(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	if params.DecayPercent == 0 || params.DecayEpochSeconds == 0 || params.DecayAccountsPerBlock == 0 {
+		return
+	}
+
+	// Decay waits for an ended season to be archived so its standings are not decayed
+	if k.GetSeasonRolloverState(ctx).SeasonId != 0 {
+		return
+	}
+
+	state := k.GetDecayState(ctx)
+	if !state.InProgress {
+		now := ctx.BlockTime().Unix()
+		if now < 0 {
+			return
+		}
+		epoch := uint64(now) / params.DecayEpochSeconds
+		if state.Epoch == 0 {
+			k.SetDecayState(ctx, types.DecayState{Epoch: epoch})
+			return
+		}
+		if epoch <= state.Epoch {
+			return
+		}
+		state = types.DecayState{Epoch: epoch, InProgress: true}
+	}
+
+	type balance struct {
+		address string
+		amount  uint64
+	}
+
+	// Collect the batch first so the balances and the leaderboard index are not written while iterating
+	store := k.storeService.OpenKVStore(ctx)
+	start := types.KudosBalanceKey(state.NextAddress)
+	iterator, err := store.Iterator(start, storetypes.PrefixEndBytes(types.KudosBalancePrefix))
+	if err != nil {
+		panic(err)
+	}
+
+	var batch []balance
+	state.NextAddress = ""
+	for ; iterator.Valid(); iterator.Next() {
+		address := string(iterator.Key()[len(types.KudosBalancePrefix):])
+		if uint32(len(batch)) == params.DecayAccountsPerBlock {
+			state.NextAddress = address
+			break
+		}
+		batch = append(batch, balance{address: address, amount: binary.BigEndian.Uint64(iterator.Value())})
+	}
+	if err := iterator.Close(); err != nil {
+		panic(err)
+	}
+
+	for _, b := range batch {
+		if b.amount == 0 {
+			continue
+		}
+		k.SetKudosBalance(ctx, b.address, decayAmount(b.amount, params.DecayPercent))
+	}
+
+	state.InProgress = state.NextAddress != ""
+	k.SetDecayState(ctx, state)
+}
+
+// decayAmount returns amount reduced by percent, rounded down, without overflowing
+func decayAmount(amount uint64, percent uint32) uint64 {
+	keep := uint64(types.MaxDecayPercent - percent)
+	return amount/100*keep + amount%100*keep/100
+}
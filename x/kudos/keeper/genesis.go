@@ -0,0 +1,201 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+// InitGenesis initializes the kudos module's state from a genesis state
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	k.SetParams(ctx, genState.Params)
+
+	for _, category := range genState.Categories {
+		k.SetCategory(ctx, category)
+	}
+
+	for _, balance := range genState.CategoryBalances {
+		k.SetCategoryBalance(ctx, balance.CategoryId, balance.Address, balance.Balance)
+	}
+
+	for _, balance := range genState.Balances {
+		k.SetKudosBalance(ctx, balance.Address, balance.Balance)
+	}
+
+	for _, lifetimeTotal := range genState.LifetimeTotals {
+		k.SetKudosLifetimeTotal(ctx, lifetimeTotal.Address, lifetimeTotal.Total)
+	}
+
+	k.SetDecayState(ctx, genState.DecayState)
+
+	if genState.CurrentSeason.Id != 0 {
+		k.SetCurrentSeason(ctx, genState.CurrentSeason)
+	}
+
+	for _, season := range genState.Seasons {
+		k.SetSeason(ctx, season)
+	}
+
+	for _, standing := range genState.SeasonStandings {
+		k.SetSeasonStanding(ctx, standing.SeasonId, types.LeaderboardEntry{
+			Address: standing.Address,
+			Balance: standing.Balance,
+			Rank:    standing.Rank,
+		})
+	}
+
+	k.SetSeasonRolloverState(ctx, genState.SeasonRollover)
+
+	for _, sentTotal := range genState.SentTotals {
+		k.SetKudosSentTotal(ctx, sentTotal.Address, sentTotal.Total)
+	}
+
+	for _, bucket := range genState.PeriodBuckets {
+		k.SetPeriodAmount(ctx, bucket.Day, bucket.Address, bucket.Amount)
+	}
+
+	for _, history := range genState.History {
+		k.SetKudosHistory(ctx, history)
+	}
+
+	k.SetHistoryCounter(ctx, genState.HistoryCounter)
+
+	for _, quota := range genState.DailyQuotas {
+		k.setDailyUsage(ctx, quota.Address, quota.Used, quota.ResetAt)
+	}
+
+	for _, allowance := range genState.BudgetAllowances {
+		k.SetBudgetAllowance(ctx, allowance.Address, allowance.Allowance)
+	}
+
+	for _, balance := range genState.GiveableBalances {
+		k.SetGiveableBalance(ctx, balance)
+	}
+
+	for _, redemption := range genState.Redemptions {
+		k.SetRedemption(ctx, redemption)
+	}
+
+	k.SetRedemptionCounter(ctx, genState.RedemptionCounter)
+
+	for _, usage := range genState.RedeemUsages {
+		k.SetRedeemUsage(ctx, usage)
+	}
+}
+
+// ExportGenesis returns the kudos module's exported genesis state
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	genState := types.DefaultGenesisState()
+	genState.Params = k.GetParams(ctx)
+
+	k.IterateCategories(ctx, func(category types.Category) bool {
+		genState.Categories = append(genState.Categories, category)
+		return false
+	})
+
+	k.IterateCategoryBalances(ctx, func(categoryID uint64, address string, balance uint64) bool {
+		genState.CategoryBalances = append(genState.CategoryBalances, types.CategoryBalance{
+			CategoryId: categoryID,
+			Address:    address,
+			Balance:    balance,
+		})
+		return false
+	})
+
+	k.IterateKudosBalances(ctx, func(address string, balance uint64) bool {
+		genState.Balances = append(genState.Balances, types.KudosBalance{
+			Address: address,
+			Balance: balance,
+		})
+		return false
+	})
+
+	k.IterateKudosLifetimeTotals(ctx, func(address string, total uint64) bool {
+		genState.LifetimeTotals = append(genState.LifetimeTotals, types.KudosLifetimeTotal{
+			Address: address,
+			Total:   total,
+		})
+		return false
+	})
+
+	genState.DecayState = k.GetDecayState(ctx)
+
+	genState.CurrentSeason, _ = k.GetCurrentSeason(ctx)
+
+	k.IterateSeasons(ctx, func(season types.Season) bool {
+		genState.Seasons = append(genState.Seasons, season)
+		return false
+	})
+
+	k.IterateSeasonStandings(ctx, func(seasonID uint64, entry types.LeaderboardEntry) bool {
+		genState.SeasonStandings = append(genState.SeasonStandings, types.SeasonStanding{
+			SeasonId: seasonID,
+			Rank:     entry.Rank,
+			Address:  entry.Address,
+			Balance:  entry.Balance,
+		})
+		return false
+	})
+
+	genState.SeasonRollover = k.GetSeasonRolloverState(ctx)
+
+	k.IterateKudosSentTotals(ctx, func(address string, total uint64) bool {
+		genState.SentTotals = append(genState.SentTotals, types.KudosSentTotal{
+			Address: address,
+			Total:   total,
+		})
+		return false
+	})
+
+	k.IteratePeriodBuckets(ctx, 0, ^uint64(0), func(day uint64, address string, amount uint64) bool {
+		genState.PeriodBuckets = append(genState.PeriodBuckets, types.PeriodBucket{
+			Day:     day,
+			Address: address,
+			Amount:  amount,
+		})
+		return false
+	})
+
+	k.IterateKudosHistory(ctx, func(history types.KudosHistory) bool {
+		genState.History = append(genState.History, history)
+		return false
+	})
+
+	genState.HistoryCounter = k.GetHistoryCounter(ctx)
+
+	k.iterateDailyUsage(ctx, func(address string, used uint64, resetAt int64) bool {
+		genState.DailyQuotas = append(genState.DailyQuotas, types.DailyQuota{
+			Address: address,
+			Used:    used,
+			ResetAt: resetAt,
+		})
+		return false
+	})
+
+	k.IterateBudgetAllowances(ctx, func(address string, allowance uint64) bool {
+		genState.BudgetAllowances = append(genState.BudgetAllowances, types.BudgetAllowance{
+			Address:   address,
+			Allowance: allowance,
+		})
+		return false
+	})
+
+	k.IterateGiveableBalances(ctx, func(balance types.GiveableBalance) bool {
+		genState.GiveableBalances = append(genState.GiveableBalances, balance)
+		return false
+	})
+
+	k.IterateRedemptions(ctx, func(redemption types.Redemption) bool {
+		genState.Redemptions = append(genState.Redemptions, redemption)
+		return false
+	})
+
+	genState.RedemptionCounter = k.GetRedemptionCounter(ctx)
+
+	k.IterateRedeemUsages(ctx, func(usage types.RedeemUsage) bool {
+		genState.RedeemUsages = append(genState.RedeemUsages, usage)
+		return false
+	})
+
+	return genState
+}
@@ -0,0 +1,200 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+func TestExportGenesisEmpty(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	genState := k.ExportGenesis(ctx)
+	require.Equal(t, types.DefaultGenesisState(), genState)
+}
+
+func TestGenesisRoundTrip(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	require.NoError(t, k.SendKudos(ctx, "cosmos1from", "cosmos1to", 10, "first", 0, nil))
+	require.NoError(t, k.SendKudos(ctx, "cosmos1from", "cosmos1other", 5, "second", 0, nil))
+	require.NoError(t, k.SendKudos(ctx, "cosmos1other", "cosmos1to", 7, "", 0, nil))
+
+	exported := k.ExportGenesis(ctx)
+	require.Len(t, exported.Balances, 2)
+	require.Len(t, exported.History, 3)
+	require.Equal(t, uint64(3), exported.HistoryCounter)
+	require.Len(t, exported.DailyQuotas, 2)
+	require.Len(t, exported.SentTotals, 2)
+	require.Len(t, exported.PeriodBuckets, 2)
+
+	require.Equal(t, uint64(1), exported.History[0].Id)
+	require.Equal(t, uint64(3), exported.History[2].Id)
+
+	// Import into a fresh store and export again
+	k2, ctx2 := setupKeeper(t)
+	k2.InitGenesis(ctx2, *exported)
+
+	require.Equal(t, uint64(17), k2.GetKudosBalance(ctx2, "cosmos1to"))
+	require.Equal(t, uint64(3), k2.GetHistoryCounter(ctx2))
+	require.Equal(t, uint64(15), k2.GetKudosSentTotal(ctx2, "cosmos1from"))
+
+	givers := k2.GetGiverLeaderboard(ctx2, 10)
+	require.Len(t, givers, 2)
+	require.Equal(t, "cosmos1from", givers[0].Address)
+
+	quota := k2.GetDailyQuota(ctx2, "cosmos1from")
+	require.Equal(t, uint64(15), quota.Used)
+
+	reexported := k2.ExportGenesis(ctx2)
+	require.Equal(t, exported, reexported)
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	require.Equal(t, cdc.MustMarshalJSON(exported), cdc.MustMarshalJSON(reexported))
+}
+
+func TestGenesisHistoryContinuesAfterImport(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	genState := types.NewGenesisState(
+		types.DefaultParams(),
+		[]types.KudosBalance{{Address: "cosmos1to", Balance: 3}},
+		[]types.KudosHistory{{Id: 1, FromAddress: "cosmos1from", ToAddress: "cosmos1to", Amount: 3}},
+		1,
+		[]types.DailyQuota{},
+		[]types.KudosSentTotal{{Address: "cosmos1from", Total: 3}},
+		nil, nil, nil, []types.KudosLifetimeTotal{{Address: "cosmos1to", Total: 3}}, types.DecayState{}, types.Season{}, nil, nil, nil, nil,
+		nil, 0, nil, types.SeasonRolloverState{},
+	)
+	k.InitGenesis(ctx, *genState)
+
+	require.NoError(t, k.SendKudos(ctx, "cosmos1from", "cosmos1to", 2, "after import", 0, nil))
+	require.Equal(t, uint64(5), k.GetKudosBalance(ctx, "cosmos1to"))
+	require.Equal(t, uint64(5), k.GetKudosSentTotal(ctx, "cosmos1from"))
+
+	exported := k.ExportGenesis(ctx)
+	require.Len(t, exported.History, 2)
+	require.Equal(t, uint64(2), exported.History[1].Id)
+	require.Equal(t, uint64(2), exported.HistoryCounter)
+}
+
+func TestGenesisCategoriesRoundTrip(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	k.SetCategory(ctx, types.NewCategory(1, "mentoring", "", true))
+	require.NoError(t, k.SendKudos(ctx, "cosmos1from", "cosmos1to", 4, "", 1, nil))
+
+	exported := k.ExportGenesis(ctx)
+	require.Len(t, exported.Categories, 1)
+	require.Equal(t, []types.CategoryBalance{{CategoryId: 1, Address: "cosmos1to", Balance: 4}}, exported.CategoryBalances)
+
+	k2, ctx2 := setupKeeper(t)
+	k2.InitGenesis(ctx2, *exported)
+	require.Equal(t, exported, k2.ExportGenesis(ctx2))
+	require.Len(t, k2.GetCategoryLeaderboard(ctx2, 1, 10), 1)
+
+	history, err := k2.KudosHistory(ctx2, &types.QueryKudosHistoryRequest{CategoryId: 1})
+	require.NoError(t, err)
+	require.Len(t, history.History, 1)
+}
+
+func TestGenesisSeasonsRoundTrip(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	params := types.DefaultParams()
+	params.SeasonLengthSeconds = 3600
+	k.SetParams(ctx, params)
+	k.ApplySeasonRollover(ctx)
+
+	require.NoError(t, k.SendKudos(ctx, "cosmos1from", "cosmos1to", 4, "", 0, nil))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	k.ApplySeasonRollover(ctx)
+
+	exported := k.ExportGenesis(ctx)
+	require.Equal(t, uint64(2), exported.CurrentSeason.Id)
+	require.Len(t, exported.Seasons, 1)
+	require.Equal(t, []types.SeasonStanding{{SeasonId: 1, Rank: 1, Address: "cosmos1to", Balance: 4}}, exported.SeasonStandings)
+
+	k2, ctx2 := setupKeeper(t)
+	k2.InitGenesis(ctx2, *exported)
+	require.Equal(t, exported, k2.ExportGenesis(ctx2))
+
+	// An archival still in progress is carried over as well
+	params.SeasonAccountsPerBlock = 1
+	k.SetParams(ctx, params)
+	require.NoError(t, k.SendKudos(ctx, "cosmos1from", "cosmos1to", 3, "", 0, nil))
+	require.NoError(t, k.SendKudos(ctx, "cosmos1from", "cosmos1other", 2, "", 0, nil))
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	k.ApplySeasonRollover(ctx)
+
+	exported = k.ExportGenesis(ctx)
+	require.Equal(t, uint64(0), exported.CurrentSeason.Id)
+	require.Equal(t, types.SeasonRolloverState{SeasonId: 2, Archived: 1}, exported.SeasonRollover)
+
+	k3, ctx3 := setupKeeper(t)
+	k3.InitGenesis(ctx3, *exported)
+	require.Equal(t, exported, k3.ExportGenesis(ctx3))
+}
+
+func TestGenesisBudgetRoundTrip(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	params := types.DefaultParams()
+	params.BudgetEnabled = true
+	k.SetParams(ctx, params)
+	k.SetBudgetAllowance(ctx, "cosmos1from", 40)
+	require.NoError(t, k.SendKudos(ctx, "cosmos1from", "cosmos1to", 4, "", 0, nil))
+
+	exported := k.ExportGenesis(ctx)
+	require.Equal(t, []types.BudgetAllowance{{Address: "cosmos1from", Allowance: 40}}, exported.BudgetAllowances)
+	require.Len(t, exported.GiveableBalances, 1)
+	require.Equal(t, uint64(36), exported.GiveableBalances[0].Remaining)
+
+	k2, ctx2 := setupKeeper(t)
+	ctx2 = ctx2.WithBlockTime(ctx.BlockTime())
+	k2.InitGenesis(ctx2, *exported)
+	require.Equal(t, exported, k2.ExportGenesis(ctx2))
+	require.Equal(t, uint64(36), k2.GetGiveable(ctx2, "cosmos1from").Remaining)
+}
+
+func TestGenesisRedemptionsRoundTrip(t *testing.T) {
+	k, ctx, bank := setupKeeperWithBank(t)
+
+	bob := sdk.AccAddress([]byte("bob_________________")).String()
+	bank.balances[k.RewardPoolAddress().String()] = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	k.SetKudosBalance(ctx, bob, 10)
+
+	params := types.DefaultParams()
+	params.RedeemDenom = "stake"
+	params.RedeemRate = 1
+	params.RedeemCapPerEpoch = 8
+	k.SetParams(ctx, params)
+
+	_, err := k.RedeemKudos(ctx, bob, 3)
+	require.NoError(t, err)
+
+	exported := k.ExportGenesis(ctx)
+	require.Len(t, exported.Redemptions, 1)
+	require.Equal(t, uint64(1), exported.RedemptionCounter)
+	require.Len(t, exported.RedeemUsages, 1)
+	require.Equal(t, uint64(3), exported.RedeemUsages[0].Redeemed)
+
+	k2, ctx2, bank2 := setupKeeperWithBank(t)
+	ctx2 = ctx2.WithBlockTime(ctx.BlockTime())
+	bank2.balances[k2.RewardPoolAddress().String()] = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	k2.InitGenesis(ctx2, *exported)
+	require.Equal(t, exported, k2.ExportGenesis(ctx2))
+
+	// The imported usage still counts against the cap and new redemptions continue the counter
+	_, err = k2.RedeemKudos(ctx2, bob, 6)
+	require.ErrorIs(t, err, types.ErrRedeemCapExceeded)
+	redemption, err := k2.RedeemKudos(ctx2, bob, 5)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), redemption.Id)
+}
@@ -0,0 +1,164 @@
+package keeper_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"cosmossdk.io/log"
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/server/api"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos"
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/keeper"
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+// setupGateway serves the keeper's queries from an in-process gRPC server and exposes them
+// through the module's gRPC-gateway routes on the SDK API server mux. It returns the base URL
+// of the REST server and the codec to decode its responses with.
+func setupGateway(t *testing.T, k keeper.Keeper, ctx sdk.Context) (string, codec.Codec) {
+	registry := codectypes.NewInterfaceRegistry()
+	cdc := codec.NewProtoCodec(registry)
+
+	listener := bufconn.Listen(1024 * 1024)
+	grpcSrv := grpc.NewServer(
+		grpc.ForceServerCodec(cdc.GRPCCodec()),
+		// Run every query against ctx, as baseapp's gRPC router does against committed state
+		grpc.UnaryInterceptor(func(goCtx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+			return handler(context.WithValue(goCtx, sdk.SdkContextKey, ctx), req)
+		}),
+	)
+	types.RegisterQueryServer(grpcSrv, k)
+	go func() { _ = grpcSrv.Serve(listener) }()
+	t.Cleanup(grpcSrv.Stop)
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return listener.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithDefaultCallOptions(grpc.ForceCodec(cdc.GRPCCodec())),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	clientCtx := client.Context{}.WithInterfaceRegistry(registry).WithCodec(cdc).WithGRPCClient(conn)
+	apiSrv := api.New(clientCtx, log.NewNopLogger(), grpcSrv)
+	kudos.AppModuleBasic{}.RegisterGRPCGatewayRoutes(clientCtx, apiSrv.GRPCGatewayRouter)
+
+	httpSrv := httptest.NewServer(apiSrv.GRPCGatewayRouter)
+	t.Cleanup(httpSrv.Close)
+
+	return httpSrv.URL, cdc
+}
+
+// getJSON performs a GET request and returns the status code and body
+func getJSON(t *testing.T, url string) (int, []byte) {
+	res, err := http.Get(url) //nolint:gosec // test server URL
+	require.NoError(t, err)
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(res.Body)
+	require.NoError(t, err)
+
+	return res.StatusCode, body
+}
+
+func TestGRPCGatewayRoutes(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	params := types.DefaultParams()
+	params.AllowedTipDenoms = []string{"stake"}
+	k.SetParams(ctx, params)
+	k.SetCategory(ctx, types.NewCategory(1, "code-review", "", true))
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1bob", 10, "review", 1, nil))
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1carol", 3, "", 0, nil))
+	require.NoError(t, k.SendKudos(ctx, "cosmos1carol", "cosmos1bob", 5, "", 0, nil))
+
+	baseURL, cdc := setupGateway(t, k, ctx)
+
+	tests := []struct {
+		name     string
+		path     string
+		response proto.Message
+		expected proto.Message
+	}{
+		{
+			name:     "balance",
+			path:     "/kudos/balance/cosmos1bob",
+			response: &types.QueryKudosBalanceResponse{},
+			expected: &types.QueryKudosBalanceResponse{Balance: 15, LifetimeTotal: 15},
+		},
+		{
+			name:     "leaderboard with limit",
+			path:     "/kudos/leaderboard?limit=1",
+			response: &types.QueryKudosLeaderboardResponse{},
+			expected: &types.QueryKudosLeaderboardResponse{
+				Entries: []types.LeaderboardEntry{{Address: "cosmos1bob", Balance: 15, Rank: 1}},
+			},
+		},
+		{
+			name:     "daily quota",
+			path:     "/kudos/daily_quota/cosmos1alice",
+			response: &types.QueryDailyQuotaResponse{},
+			expected: &types.QueryDailyQuotaResponse{
+				Used:      13,
+				Remaining: types.DefaultDailyLimit - 13,
+				Limit:     types.DefaultDailyLimit,
+				ResetAt:   k.GetDailyQuota(ctx, "cosmos1alice").ResetAt,
+			},
+		},
+		{
+			name:     "category leaderboard",
+			path:     "/kudos/categories/1/leaderboard",
+			response: &types.QueryCategoryLeaderboardResponse{},
+			expected: &types.QueryCategoryLeaderboardResponse{
+				Entries: []types.LeaderboardEntry{{Address: "cosmos1bob", Balance: 10, Rank: 1}},
+			},
+		},
+		{
+			name:     "params",
+			path:     "/kudos/params",
+			response: &types.QueryParamsResponse{},
+			expected: &types.QueryParamsResponse{Params: params},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			status, body := getJSON(t, baseURL+tc.path)
+			require.Equal(t, http.StatusOK, status, string(body))
+			require.NoError(t, cdc.UnmarshalJSON(body, tc.response))
+
+			// Pagination is checked separately below
+			switch res := tc.response.(type) {
+			case *types.QueryKudosLeaderboardResponse:
+				res.Pagination = nil
+			case *types.QueryCategoryLeaderboardResponse:
+				res.Pagination = nil
+			}
+			require.Equal(t, tc.expected, tc.response)
+		})
+	}
+
+	// Nested pagination fields are read from the query string
+	status, body := getJSON(t, baseURL+"/kudos/history/sent/cosmos1alice?pagination.limit=1")
+	require.Equal(t, http.StatusOK, status, string(body))
+	var sent types.QueryKudosSentResponse
+	require.NoError(t, cdc.UnmarshalJSON(body, &sent))
+	require.Equal(t, []uint64{2}, historyIDs(sent.History))
+	require.NotEmpty(t, sent.Pagination.NextKey)
+
+	// Path parameters are converted to the request field type
+	status, _ = getJSON(t, baseURL+"/kudos/seasons/not-a-number")
+	require.Equal(t, http.StatusBadRequest, status)
+}
@@ -0,0 +1,203 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+// RegisterInvariants registers all kudos invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "balances", BalancesInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "history-counter", HistoryCounterInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "daily-quota", DailyQuotaInvariant(k))
+}
+
+// AllInvariants runs all invariants of the kudos module
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		for _, invariant := range invariants(k) {
+			if res, stop := invariant(ctx); stop {
+				return res, stop
+			}
+		}
+		return "", false
+	}
+}
+
+// AssertInvariants runs every kudos invariant and returns an error listing all broken ones,
+// or nil when the state is consistent. It is meant for tests and simulations, which can check
+// the kudos state without an x/crisis keeper.
+func AssertInvariants(ctx sdk.Context, k Keeper) error {
+	var broken []string
+	for _, invariant := range invariants(k) {
+		if res, stop := invariant(ctx); stop {
+			broken = append(broken, res)
+		}
+	}
+
+	if len(broken) == 0 {
+		return nil
+	}
+	return errorsmod.Wrap(types.ErrInvariantBroken, strings.Join(broken, "\n"))
+}
+
+// invariants returns the kudos invariants in the order they are registered
+func invariants(k Keeper) []sdk.Invariant {
+	return []sdk.Invariant{
+		BalancesInvariant(k),
+		HistoryCounterInvariant(k),
+		DailyQuotaInvariant(k),
+	}
+}
+
+// BalancesInvariant checks the balances and lifetime totals of every address against the
+// non-revoked history it received. No balance may exceed its lifetime total. When the
+// history is complete, i.e. it starts at ID 1 and runs up to the counter, each lifetime total
+// must equal what the address received, and so must each balance as long as decay, a season
+// reset or a redemption has never lowered one.
+func BalancesInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg            string
+			count          int
+			firstID, maxID uint64
+			totalReceived  uint64
+			totalBalance   uint64
+		)
+
+		received := make(map[string]uint64)
+		k.IterateKudosHistory(ctx, func(history types.KudosHistory) bool {
+			if firstID == 0 {
+				firstID = history.Id
+			}
+			maxID = history.Id
+
+			if !history.Revoked {
+				received[history.ToAddress] += history.Amount
+				totalReceived += history.Amount
+			}
+			return false
+		})
+
+		counter := k.GetHistoryCounter(ctx)
+		historyComplete := (maxID == 0 && counter == 0) || (firstID == 1 && maxID == counter)
+		balancesLowered := k.GetDecayState(ctx).Epoch != 0 || k.lastSeasonID(ctx) != 0 || k.GetRedemptionCounter(ctx) != 0
+
+		balances := k.GetAllKudosBalances(ctx)
+		lifetimeTotals := make(map[string]uint64)
+		k.IterateKudosLifetimeTotals(ctx, func(address string, total uint64) bool {
+			lifetimeTotals[address] = total
+			return false
+		})
+
+		for _, address := range sortedAddresses(balances, lifetimeTotals, received) {
+			balance, lifetimeTotal := balances[address], lifetimeTotals[address]
+			totalBalance += balance
+
+			if balance > lifetimeTotal {
+				count++
+				msg += fmt.Sprintf("\t%s has balance %d above its lifetime total %d\n", address, balance, lifetimeTotal)
+			}
+			if !historyComplete {
+				continue
+			}
+			if lifetimeTotal != received[address] {
+				count++
+				msg += fmt.Sprintf("\t%s has lifetime total %d but received %d in non-revoked history\n", address, lifetimeTotal, received[address])
+			}
+			if !balancesLowered && balance != received[address] {
+				count++
+				msg += fmt.Sprintf("\t%s has balance %d but received %d in non-revoked history\n", address, balance, received[address])
+			}
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "balances",
+			fmt.Sprintf("%d broken kudos balances found\n%s\tsum of balances: %d, sum of non-revoked history: %d\n", count, msg, totalBalance, totalReceived),
+		), count != 0
+	}
+}
+
+// HistoryCounterInvariant checks that the history counter equals the highest stored history
+// ID, so that the next entry does not overwrite an existing one. A counter without any stored
+// history is accepted, since genesis may have pruned all of it.
+func HistoryCounterInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		counter := k.GetHistoryCounter(ctx)
+
+		var (
+			maxID uint64
+			above []uint64
+		)
+		k.IterateKudosHistory(ctx, func(history types.KudosHistory) bool {
+			maxID = history.Id
+			if history.Id > counter {
+				above = append(above, history.Id)
+			}
+			return false
+		})
+
+		broken := maxID != 0 && maxID != counter
+
+		msg := fmt.Sprintf("history counter %d, highest history id %d\n", counter, maxID)
+		if len(above) > 0 {
+			msg += fmt.Sprintf("\thistory ids above the counter: %v\n", above)
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, "history-counter", msg), broken
+	}
+}
+
+// DailyQuotaInvariant checks that no open quota window has been charged more than its sender
+// has sent, since every charge records a transfer and a revoked one is only refunded to the
+// window it was charged to. Expired windows no longer count and are skipped. Usage is not
+// compared with the daily limit: governance may lower it while a window is open, which only
+// leaves that sender with nothing left until the window resets.
+func DailyQuotaInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		now := ctx.BlockTime().Unix()
+		k.iterateDailyUsage(ctx, func(address string, used uint64, resetAt int64) bool {
+			if now >= resetAt {
+				return false
+			}
+			if sent := k.GetKudosSentTotal(ctx, address); used > sent {
+				count++
+				msg += fmt.Sprintf("\t%s has used %d in its open quota window but sent %d in total\n", address, used, sent)
+			}
+			return false
+		})
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "daily-quota",
+			fmt.Sprintf("%d quota trackers above what their sender sent found\n%s", count, msg),
+		), count != 0
+	}
+}
+
+// sortedAddresses returns the addresses keyed in any of the maps, in ascending order
+func sortedAddresses(amounts ...map[string]uint64) []string {
+	seen := make(map[string]bool)
+	var addresses []string
+	for _, m := range amounts {
+		for address := range m {
+			if !seen[address] {
+				seen[address] = true
+				addresses = append(addresses, address)
+			}
+		}
+	}
+
+	sort.Strings(addresses)
+	return addresses
+}
@@ -0,0 +1,142 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/keeper"
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+// setupInvariantState sends and revokes a few transfers and returns the addresses involved
+func setupInvariantState(t *testing.T) (keeper.Keeper, sdk.Context, string, string, string) {
+	k, ctx := setupKeeper(t)
+
+	alice := sdk.AccAddress("alice_______________").String()
+	bob := sdk.AccAddress("bob_________________").String()
+	carol := sdk.AccAddress("carol_______________").String()
+
+	require.NoError(t, k.SendKudos(ctx, alice, bob, 5, "", 0, nil))
+	require.NoError(t, k.SendKudos(ctx, alice, carol, 3, "", 0, nil))
+	require.NoError(t, k.SendKudos(ctx, bob, carol, 2, "", 0, nil))
+	require.NoError(t, k.RevokeKudos(ctx, alice, 2))
+
+	return k, ctx, alice, bob, carol
+}
+
+func TestInvariantsHold(t *testing.T) {
+	k, ctx, _, _, _ := setupInvariantState(t)
+
+	require.NoError(t, keeper.AssertInvariants(ctx, k))
+
+	msg, broken := keeper.AllInvariants(k)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestBalancesInvariant(t *testing.T) {
+	t.Run("balance differs from history", func(t *testing.T) {
+		k, ctx, _, bob, _ := setupInvariantState(t)
+		k.SetKudosBalance(ctx, bob, 4)
+
+		msg, broken := keeper.BalancesInvariant(k)(ctx)
+		require.True(t, broken)
+		require.Contains(t, msg, bob+" has balance 4 but received 5 in non-revoked history")
+		require.Contains(t, msg, "sum of balances: 6, sum of non-revoked history: 7")
+	})
+
+	t.Run("lifetime total differs from history", func(t *testing.T) {
+		k, ctx, _, _, carol := setupInvariantState(t)
+		k.SetKudosLifetimeTotal(ctx, carol, 5)
+
+		msg, broken := keeper.BalancesInvariant(k)(ctx)
+		require.True(t, broken)
+		require.Contains(t, msg, carol+" has lifetime total 5 but received 2 in non-revoked history")
+	})
+
+	t.Run("decayed balances may be lower", func(t *testing.T) {
+		k, ctx, _, bob, _ := setupInvariantState(t)
+		k.SetDecayState(ctx, types.DecayState{Epoch: 1})
+		k.SetKudosBalance(ctx, bob, 4)
+
+		msg, broken := keeper.BalancesInvariant(k)(ctx)
+		require.False(t, broken, msg)
+
+		k.SetKudosBalance(ctx, bob, 6)
+		msg, broken = keeper.BalancesInvariant(k)(ctx)
+		require.True(t, broken)
+		require.Contains(t, msg, bob+" has balance 6 above its lifetime total 5")
+	})
+
+	t.Run("pruned history only bounds balances", func(t *testing.T) {
+		k, ctx := setupKeeper(t)
+		alice := sdk.AccAddress("alice_______________").String()
+		bob := sdk.AccAddress("bob_________________").String()
+
+		// the first transfer was pruned from genesis, so bob's totals cannot be checked against history
+		genState := types.DefaultGenesisState()
+		genState.History = []types.KudosHistory{{Id: 2, FromAddress: alice, ToAddress: bob, Amount: 5, Timestamp: 1}}
+		genState.HistoryCounter = 2
+		genState.Balances = []types.KudosBalance{{Address: bob, Balance: 100}}
+		genState.LifetimeTotals = []types.KudosLifetimeTotal{{Address: bob, Total: 100}}
+		require.NoError(t, genState.Validate())
+		k.InitGenesis(ctx, *genState)
+
+		msg, broken := keeper.BalancesInvariant(k)(ctx)
+		require.False(t, broken, msg)
+
+		k.SetKudosBalance(ctx, bob, 101)
+		msg, broken = keeper.BalancesInvariant(k)(ctx)
+		require.True(t, broken)
+		require.Contains(t, msg, bob+" has balance 101 above its lifetime total 100")
+	})
+}
+
+func TestHistoryCounterInvariant(t *testing.T) {
+	k, ctx, _, _, _ := setupInvariantState(t)
+
+	msg, broken := keeper.HistoryCounterInvariant(k)(ctx)
+	require.False(t, broken, msg)
+
+	k.SetHistoryCounter(ctx, 1)
+	msg, broken = keeper.HistoryCounterInvariant(k)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "history counter 1, highest history id 3")
+	require.Contains(t, msg, "history ids above the counter: [2 3]")
+
+	k.SetHistoryCounter(ctx, 4)
+	msg, broken = keeper.HistoryCounterInvariant(k)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "history counter 4, highest history id 3")
+}
+
+func TestDailyQuotaInvariant(t *testing.T) {
+	k, ctx, alice, bob, _ := setupInvariantState(t)
+
+	// alice has used 5 after the revoke refunded 3, bob has used 2
+	k.SetKudosSentTotal(ctx, alice, 4)
+
+	msg, broken := keeper.DailyQuotaInvariant(k)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, alice+" has used 5 in its open quota window but sent 4 in total")
+	require.NotContains(t, msg, bob)
+
+	err := keeper.AssertInvariants(ctx, k)
+	require.ErrorIs(t, err, types.ErrInvariantBroken)
+	require.Contains(t, err.Error(), alice)
+
+	// an expired window no longer counts
+	expired := ctx.WithBlockTime(ctx.BlockTime().Add(time.Duration(types.DailyQuotaWindowSeconds) * time.Second))
+	msg, broken = keeper.DailyQuotaInvariant(k)(expired)
+	require.False(t, broken, msg)
+
+	// usage above a limit lowered while the window is open is not broken
+	k.SetKudosSentTotal(ctx, alice, 5)
+	params := k.GetParams(ctx)
+	params.DailyLimit = 3
+	_, err = keeper.NewMsgServerImpl(k).UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: params})
+	require.NoError(t, err)
+	require.NoError(t, keeper.AssertInvariants(ctx, k))
+}
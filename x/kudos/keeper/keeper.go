@@ -3,39 +3,83 @@ package keeper
 import (
 	"encoding/binary"
 	"fmt"
-	"sort"
 	"time"
 
 	"cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	cmttypes "github.com/cometbft/cometbft/types"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
 )
 
-const (
-	dailyLimitWindow = time.Duration(types.DailyQuotaWindowSeconds) * time.Second
-)
-
 // Keeper maintains the link to data storage and exposes getter/setter methods
 type Keeper struct {
 	cdc          codec.BinaryCodec
 	storeService store.KVStoreService
+	tStoreKey    *storetypes.TransientStoreKey
 	logger       log.Logger
+	bankKeeper   types.BankKeeper
+
+	// hooks is shared by every copy of the keeper, so hooks set after the module has taken its copy
+	// are still seen by the module
+	hooks *types.KudosHooks
+
+	// the address capable of executing a MsgUpdateParams message, typically the x/gov module account
+	authority string
 }
 
-// NewKeeper creates a new kudos Keeper instance
+// NewKeeper creates a new kudos Keeper instance. bankKeeper may be nil, in which case tips and the
+// reward pool are unavailable and only plain kudos transfers work. The transient store only holds
+// per-block bookkeeping such as the kudos message index of the transaction being executed.
 func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeService store.KVStoreService,
+	tStoreKey *storetypes.TransientStoreKey,
 	logger log.Logger,
+	bankKeeper types.BankKeeper,
+	authority string,
 ) Keeper {
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
+	}
+
 	return Keeper{
 		cdc:          cdc,
 		storeService: storeService,
+		tStoreKey:    tStoreKey,
 		logger:       logger,
+		bankKeeper:   bankKeeper,
+		hooks:        new(types.KudosHooks),
+		authority:    authority,
+	}
+}
+
+// GetAuthority returns the module's authority
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// SetHooks registers the hooks other modules use to react to kudos transfers. The hooks are shared
+// by all copies of the keeper, so it may be called after the keeper has been handed to the module.
+func (k Keeper) SetHooks(hooks types.KudosHooks) {
+	if *k.hooks != nil {
+		panic("cannot set kudos hooks twice")
 	}
+
+	*k.hooks = hooks
+}
+
+// Hooks returns the registered kudos hooks, or a no-op set when none are registered
+func (k Keeper) Hooks() types.KudosHooks {
+	if k.hooks == nil || *k.hooks == nil {
+		return types.MultiKudosHooks{}
+	}
+
+	return *k.hooks
 }
 
 // Logger returns a module-specific logger
@@ -56,17 +100,55 @@ func (k Keeper) GetKudosBalance(ctx sdk.Context, address string) uint64 {
 	return binary.BigEndian.Uint64(bz)
 }
 
-// SetKudosBalance sets the kudos balance for an address
+// SetKudosBalance sets the kudos balance for an address and keeps the leaderboard index in sync
 func (k Keeper) SetKudosBalance(ctx sdk.Context, address string, balance uint64) {
+	k.setRankedAmount(ctx, types.KudosBalanceKey(address), types.LeaderboardKey, address, balance)
+}
+
+// GetKudosSentTotal returns the lifetime total of kudos sent by an address
+func (k Keeper) GetKudosSentTotal(ctx sdk.Context, address string) uint64 {
 	store := k.storeService.OpenKVStore(ctx)
-	key := types.KudosBalanceKey(address)
+
+	bz, err := store.Get(types.KudosSentTotalKey(address))
+	if err != nil || bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetKudosSentTotal sets the lifetime total sent by an address and keeps the giver leaderboard index in sync
+func (k Keeper) SetKudosSentTotal(ctx sdk.Context, address string, total uint64) {
+	k.setRankedAmount(ctx, types.KudosSentTotalKey(address), types.GiverLeaderboardKey, address, total)
+}
+
+// setRankedAmount stores an amount under key and moves the address to its new position
+// in the ranked index built by indexKey; zero amounts are left out of the index
+func (k Keeper) setRankedAmount(ctx sdk.Context, key []byte, indexKey func(string, uint64) []byte, address string, amount uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	oldBz, err := store.Get(key)
+	if err != nil {
+		panic(err)
+	}
+	if oldBz != nil {
+		if err := store.Delete(indexKey(address, binary.BigEndian.Uint64(oldBz))); err != nil {
+			panic(err)
+		}
+	}
 
 	bz := make([]byte, 8)
-	binary.BigEndian.PutUint64(bz, balance)
+	binary.BigEndian.PutUint64(bz, amount)
 
 	if err := store.Set(key, bz); err != nil {
 		panic(err)
 	}
+
+	if amount > 0 {
+		if err := store.Set(indexKey(address, amount), []byte{}); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // AddKudos adds kudos to an address balance
@@ -106,24 +188,69 @@ func (k Keeper) setDailyUsage(ctx sdk.Context, address string, used uint64, rese
 	}
 }
 
-// rolloverDailyUsage ensures we work within a fresh quota window when it has expired
+// iterateDailyUsage iterates over all quota trackers in address order until cb returns true
+func (k Keeper) iterateDailyUsage(ctx sdk.Context, cb func(address string, used uint64, resetAt int64) (stop bool)) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	iterator, err := store.Iterator(types.DailySentPrefix, storetypes.PrefixEndBytes(types.DailySentPrefix))
+	if err != nil {
+		panic(err)
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		address := string(iterator.Key()[len(types.DailySentPrefix):])
+		bz := iterator.Value()
+		used := binary.BigEndian.Uint64(bz[:8])
+		resetAt := int64(binary.BigEndian.Uint64(bz[8:]))
+
+		if cb(address, used, resetAt) {
+			break
+		}
+	}
+}
+
+// rolloverDailyUsage ensures we work within a fresh quota window when it has expired. The reset
+// is only reported when the expired window had kudos charged to it, not when an address sends
+// for the first time or its last window went unused.
 func (k Keeper) rolloverDailyUsage(ctx sdk.Context, address string) (uint64, int64) {
-	used, resetAt := k.getDailyUsage(ctx, address)
-	now := ctx.BlockTime()
+	previousUsed, previousResetAt := k.getDailyUsage(ctx, address)
+	used, resetAt := k.currentDailyUsage(ctx, previousUsed, previousResetAt)
+	if resetAt == previousResetAt {
+		return used, resetAt
+	}
+
+	k.setDailyUsage(ctx, address, used, resetAt)
 
-	if resetAt == 0 || now.Unix() >= resetAt {
-		resetAt = now.Add(dailyLimitWindow).Unix()
-		used = 0
-		k.setDailyUsage(ctx, address, used, resetAt)
+	if previousUsed > 0 {
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventDailyQuotaReset{
+			Address:      address,
+			PreviousUsed: previousUsed,
+			ResetAt:      resetAt,
+		}); err != nil {
+			panic(err)
+		}
 	}
 
 	return used, resetAt
 }
 
+// currentDailyUsage returns the usage and reset time of the quota window open at the current
+// block, starting a new one when the stored window has expired or there is none
+func (k Keeper) currentDailyUsage(ctx sdk.Context, used uint64, resetAt int64) (uint64, int64) {
+	now := ctx.BlockTime()
+	if resetAt != 0 && now.Unix() < resetAt {
+		return used, resetAt
+	}
+
+	window := time.Duration(k.GetParams(ctx).QuotaWindowSeconds) * time.Second
+	return 0, now.Add(window).Unix()
+}
+
 // trackDailyUsage updates the quota tracker and returns an error when the limit is exceeded
 func (k Keeper) trackDailyUsage(ctx sdk.Context, address string, amount uint64) (uint64, int64, error) {
 	used, resetAt := k.rolloverDailyUsage(ctx, address)
-	limit := types.DefaultDailyLimit
+	limit := k.GetParams(ctx).DailyLimit
 
 	if used+amount > limit {
 		return used, resetAt, types.ErrDailyLimitExceeded
@@ -159,81 +286,231 @@ func (k Keeper) SetHistoryCounter(ctx sdk.Context, counter uint64) {
 	}
 }
 
-// AddKudosHistory adds a kudos transaction to history
-func (k Keeper) AddKudosHistory(ctx sdk.Context, fromAddress, toAddress string, amount uint64, comment string) {
+// AddKudosHistory adds a kudos transaction to history and returns the recorded entry; categoryID
+// is 0 for uncategorized kudos and tip is empty when no coins were attached
+func (k Keeper) AddKudosHistory(ctx sdk.Context, fromAddress, toAddress string, amount uint64, comment string, categoryID uint64, tip sdk.Coins) types.KudosHistory {
 	counter := k.GetHistoryCounter(ctx)
 	counter++
 
 	history := types.KudosHistory{
-		FromAddress: fromAddress,
-		ToAddress:   toAddress,
-		Amount:      amount,
-		Comment:     comment,
-		Timestamp:   time.Now().Unix(),
+		FromAddress:   fromAddress,
+		ToAddress:     toAddress,
+		Amount:        amount,
+		Comment:       comment,
+		Timestamp:     ctx.BlockTime().Unix(),
+		Id:            counter,
+		BlockHeight:   ctx.BlockHeight(),
+		TxHash:        txHash(ctx),
+		KudosMsgIndex: msgIndex(ctx),
+		CategoryId:    categoryID,
+		Tip:           tip,
+	}
+
+	k.SetKudosHistory(ctx, history)
+	k.SetHistoryCounter(ctx, counter)
+
+	return history
+}
+
+// msgIndexKey is the context key carrying the index assigned by withNextMsgIndex
+type msgIndexKey struct{}
+
+// withNextMsgIndex assigns the next kudos message index within the current transaction
+// and returns a context carrying it for the history entries the message records. The
+// counter lives in the transient store, so it never becomes part of the consensus state.
+func (k Keeper) withNextMsgIndex(ctx sdk.Context) sdk.Context {
+	hash := txHash(ctx)
+	if hash == "" {
+		return ctx
+	}
+
+	store := ctx.TransientStore(k.tStoreKey)
+
+	bz := store.Get(types.TxMsgIndexKey)
+
+	var index uint32
+	if len(bz) > 4 && string(bz[4:]) == hash {
+		index = binary.BigEndian.Uint32(bz[:4])
 	}
 
+	next := make([]byte, 4)
+	binary.BigEndian.PutUint32(next, index+1)
+
+	store.Set(types.TxMsgIndexKey, append(next, []byte(hash)...))
+
+	return ctx.WithValue(msgIndexKey{}, index)
+}
+
+// msgIndex returns the kudos message index assigned to the context, or 0 outside a message
+func msgIndex(ctx sdk.Context) uint32 {
+	index, _ := ctx.Value(msgIndexKey{}).(uint32)
+	return index
+}
+
+// txHash returns the hex encoded hash of the transaction being executed, or "" outside a transaction
+func txHash(ctx sdk.Context) string {
+	if len(ctx.TxBytes()) == 0 {
+		return ""
+	}
+
+	return fmt.Sprintf("%X", cmttypes.Tx(ctx.TxBytes()).Hash())
+}
+
+// SetKudosHistory stores a kudos history entry under its ID along with its sender, recipient
+// and category indexes
+func (k Keeper) SetKudosHistory(ctx sdk.Context, history types.KudosHistory) {
 	store := k.storeService.OpenKVStore(ctx)
-	key := types.KudosHistoryKey(counter)
+	key := types.KudosHistoryKey(history.Id)
 	bz := k.cdc.MustMarshal(&history)
 
 	if err := store.Set(key, bz); err != nil {
 		panic(err)
 	}
 
-	k.SetHistoryCounter(ctx, counter)
+	if err := store.Set(types.HistoryBySenderKey(history.FromAddress, history.Id), []byte{}); err != nil {
+		panic(err)
+	}
+
+	if err := store.Set(types.HistoryByRecipientKey(history.ToAddress, history.Id), []byte{}); err != nil {
+		panic(err)
+	}
+
+	if history.CategoryId != 0 {
+		if err := store.Set(types.HistoryByCategoryKey(history.CategoryId, history.Id), []byte{}); err != nil {
+			panic(err)
+		}
+	}
 }
 
-// GetAllKudosBalances returns all kudos balances
-func (k Keeper) GetAllKudosBalances(ctx sdk.Context) map[string]uint64 {
+// GetKudosHistory returns the history entry with the given ID
+func (k Keeper) GetKudosHistory(ctx sdk.Context, id uint64) (types.KudosHistory, bool) {
 	store := k.storeService.OpenKVStore(ctx)
-	balances := make(map[string]uint64)
 
-	iterator, err := store.Iterator(types.KudosBalancePrefix, sdk.PrefixEndBytes(types.KudosBalancePrefix))
+	bz, err := store.Get(types.KudosHistoryKey(id))
+	if err != nil || bz == nil {
+		return types.KudosHistory{}, false
+	}
+
+	var history types.KudosHistory
+	k.cdc.MustUnmarshal(bz, &history)
+	history.Id = id
+
+	return history, true
+}
+
+// IterateKudosHistory iterates over all history entries in ID order until cb returns true
+func (k Keeper) IterateKudosHistory(ctx sdk.Context, cb func(history types.KudosHistory) (stop bool)) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	iterator, err := store.Iterator(types.KudosHistoryPrefix, storetypes.PrefixEndBytes(types.KudosHistoryPrefix))
 	if err != nil {
-		return balances
+		panic(err)
 	}
 	defer iterator.Close()
 
 	for ; iterator.Valid(); iterator.Next() {
-		key := iterator.Key()
-		address := string(key[len(types.KudosBalancePrefix):])
-		balance := binary.BigEndian.Uint64(iterator.Value())
-		balances[address] = balance
+		var history types.KudosHistory
+		k.cdc.MustUnmarshal(iterator.Value(), &history)
+		history.Id = binary.BigEndian.Uint64(iterator.Key()[len(types.KudosHistoryPrefix):])
+
+		if cb(history) {
+			break
+		}
 	}
+}
+
+// IterateKudosBalances iterates over all kudos balances in address order until cb returns true
+func (k Keeper) IterateKudosBalances(ctx sdk.Context, cb func(address string, balance uint64) (stop bool)) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	iterator, err := store.Iterator(types.KudosBalancePrefix, storetypes.PrefixEndBytes(types.KudosBalancePrefix))
+	if err != nil {
+		panic(err)
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		address := string(iterator.Key()[len(types.KudosBalancePrefix):])
+		if cb(address, binary.BigEndian.Uint64(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// IterateKudosSentTotals iterates over all lifetime sent totals in address order until cb returns true
+func (k Keeper) IterateKudosSentTotals(ctx sdk.Context, cb func(address string, total uint64) (stop bool)) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	iterator, err := store.Iterator(types.KudosSentTotalPrefix, storetypes.PrefixEndBytes(types.KudosSentTotalPrefix))
+	if err != nil {
+		panic(err)
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		address := string(iterator.Key()[len(types.KudosSentTotalPrefix):])
+		if cb(address, binary.BigEndian.Uint64(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// GetAllKudosBalances returns all kudos balances
+func (k Keeper) GetAllKudosBalances(ctx sdk.Context) map[string]uint64 {
+	balances := make(map[string]uint64)
+
+	k.IterateKudosBalances(ctx, func(address string, balance uint64) bool {
+		balances[address] = balance
+		return false
+	})
 
 	return balances
 }
 
-// GetLeaderboard returns the top N kudos receivers
+// GetLeaderboard returns the top N kudos receivers, highest balance first with ties ordered by address
 func (k Keeper) GetLeaderboard(ctx sdk.Context, limit uint32) []types.LeaderboardEntry {
-	balances := k.GetAllKudosBalances(ctx)
+	return k.getRankedEntries(ctx, types.LeaderboardPrefix, limit)
+}
 
-	// Convert map to slice for sorting
-	entries := make([]types.LeaderboardEntry, 0, len(balances))
-	for address, balance := range balances {
-		entries = append(entries, types.LeaderboardEntry{
-			Address: address,
-			Balance: balance,
-		})
+// GetGiverLeaderboard returns the top N kudos givers by lifetime total sent, ties ordered by address.
+// The Balance of each entry holds the total sent.
+func (k Keeper) GetGiverLeaderboard(ctx sdk.Context, limit uint32) []types.LeaderboardEntry {
+	return k.getRankedEntries(ctx, types.GiverLeaderboardPrefix, limit)
+}
+
+// getRankedEntries returns up to limit entries of a ranked index, 0 meaning no limit
+func (k Keeper) getRankedEntries(ctx sdk.Context, indexPrefix []byte, limit uint32) []types.LeaderboardEntry {
+	store := k.storeService.OpenKVStore(ctx)
+
+	iterator, err := store.Iterator(indexPrefix, storetypes.PrefixEndBytes(indexPrefix))
+	if err != nil {
+		panic(err)
 	}
+	defer iterator.Close()
 
-	// Sort by balance descending
-	sort.Slice(entries, func(i, j int) bool {
-		return entries[i].Balance > entries[j].Balance
-	})
+	var entries []types.LeaderboardEntry
+	for ; iterator.Valid(); iterator.Next() {
+		if limit > 0 && uint32(len(entries)) >= limit {
+			break
+		}
 
-	// Apply limit
-	if limit > 0 && uint32(len(entries)) > limit {
-		entries = entries[:limit]
+		address, amount := types.ParseLeaderboardKey(iterator.Key()[len(indexPrefix):])
+		entries = append(entries, types.LeaderboardEntry{
+			Address: address,
+			Balance: amount,
+			Rank:    uint64(len(entries) + 1),
+		})
 	}
 
 	return entries
 }
 
-// GetDailyQuota returns quota usage info for an address, refreshing the window if needed
+// GetDailyQuota returns quota usage info for an address. An expired window is reported as the
+// fresh one the next transfer would open, without writing state or emitting events.
 func (k Keeper) GetDailyQuota(ctx sdk.Context, address string) types.QueryDailyQuotaResponse {
-	used, resetAt := k.rolloverDailyUsage(ctx, address)
-	limit := types.DefaultDailyLimit
+	used, resetAt := k.getDailyUsage(ctx, address)
+	used, resetAt = k.currentDailyUsage(ctx, used, resetAt)
+	limit := k.GetParams(ctx).DailyLimit
 	remaining := limit
 	if used >= limit {
 		remaining = 0
@@ -249,8 +526,96 @@ func (k Keeper) GetDailyQuota(ctx sdk.Context, address string) types.QueryDailyQ
 	}
 }
 
-// SendKudos sends kudos from one address to another
-func (k Keeper) SendKudos(ctx sdk.Context, fromAddress, toAddress string, amount uint64, comment string) error {
+// SendKudos sends kudos from one address to another, optionally within a category (0 for none)
+// and with a tip of coins transferred through x/bank in the same message (empty for none)
+func (k Keeper) SendKudos(ctx sdk.Context, fromAddress, toAddress string, amount uint64, comment string, categoryID uint64, tip sdk.Coins) error {
+	if err := k.validateTransfer(ctx, fromAddress, toAddress, amount, comment, categoryID); err != nil {
+		return err
+	}
+
+	if err := k.validateTip(ctx, fromAddress, toAddress, tip); err != nil {
+		return err
+	}
+
+	// Balances are frozen while an ended season is archived
+	if err := k.checkSeasonRollover(ctx); err != nil {
+		return err
+	}
+
+	// In budget mode the sender must have enough giveable allowance left
+	if err := k.checkGiveable(ctx, fromAddress, amount); err != nil {
+		return err
+	}
+
+	// Other modules may veto the transfer before anything is written
+	if err := k.Hooks().BeforeKudosSent(ctx, fromAddress, toAddress, amount, categoryID); err != nil {
+		return err
+	}
+
+	// Enforce daily quota for sender
+	if _, _, err := k.trackDailyUsage(ctx, fromAddress, amount); err != nil {
+		return err
+	}
+
+	// The tip and, once the kudos are credited, the AfterKudosSent hook can still fail after the
+	// quota has been charged; the message is reverted as a whole if either does
+	if err := k.sendTip(ctx, fromAddress, toAddress, tip); err != nil {
+		return err
+	}
+	k.spendGiveable(ctx, fromAddress, amount)
+
+	return k.transferKudos(ctx, fromAddress, toAddress, amount, comment, categoryID, tip)
+}
+
+// MultiSendKudos sends kudos from one address to several recipients. Every output is
+// validated and the total is checked against the giveable allowance and daily quota once
+// before any state is written, so either all outputs are applied or none.
+func (k Keeper) MultiSendKudos(ctx sdk.Context, fromAddress string, outputs []types.KudosOutput) error {
+	if len(outputs) == 0 {
+		return errorsmod.Wrap(types.ErrInvalidRequest, "at least one output is required")
+	}
+
+	var total uint64
+	for i, output := range outputs {
+		if err := k.validateTransfer(ctx, fromAddress, output.ToAddress, output.Amount, output.Comment, output.CategoryId); err != nil {
+			return errorsmod.Wrapf(err, "outputs[%d]", i)
+		}
+		if total+output.Amount < total {
+			return errorsmod.Wrapf(types.ErrInvalidAmount, "outputs[%d]: total amount overflows", i)
+		}
+		total += output.Amount
+	}
+
+	// Balances are frozen while an ended season is archived
+	if err := k.checkSeasonRollover(ctx); err != nil {
+		return err
+	}
+
+	// Enforce the giveable allowance and daily quota for sender against the total of all outputs
+	if err := k.checkGiveable(ctx, fromAddress, total); err != nil {
+		return err
+	}
+	for i, output := range outputs {
+		if err := k.Hooks().BeforeKudosSent(ctx, fromAddress, output.ToAddress, output.Amount, output.CategoryId); err != nil {
+			return errorsmod.Wrapf(err, "outputs[%d]", i)
+		}
+	}
+	if _, _, err := k.trackDailyUsage(ctx, fromAddress, total); err != nil {
+		return err
+	}
+	k.spendGiveable(ctx, fromAddress, total)
+
+	for _, output := range outputs {
+		if err := k.transferKudos(ctx, fromAddress, output.ToAddress, output.Amount, output.Comment, output.CategoryId, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateTransfer checks a single transfer against the current params without touching state
+func (k Keeper) validateTransfer(ctx sdk.Context, fromAddress, toAddress string, amount uint64, comment string, categoryID uint64) error {
 	// Validate addresses are different
 	if fromAddress == toAddress {
 		return types.ErrSameAddress
@@ -261,27 +626,76 @@ func (k Keeper) SendKudos(ctx sdk.Context, fromAddress, toAddress string, amount
 		return types.ErrInvalidAmount
 	}
 
-	// Enforce daily quota for sender
-	if _, _, err := k.trackDailyUsage(ctx, fromAddress, amount); err != nil {
-		return err
+	// Validate comment length against the current params
+	if maxLength := k.GetParams(ctx).MaxCommentLength; len(comment) > int(maxLength) {
+		return errorsmod.Wrapf(types.ErrCommentTooLong, "comment length %d exceeds %d characters", len(comment), maxLength)
+	}
+
+	// Categories are optional but must be registered and active when given
+	if categoryID != 0 {
+		category, found := k.GetCategory(ctx, categoryID)
+		if !found {
+			return errorsmod.Wrapf(types.ErrInvalidCategory, "category %d not found", categoryID)
+		}
+		if !category.Active {
+			return errorsmod.Wrapf(types.ErrInvalidCategory, "category %d is not active", categoryID)
+		}
 	}
 
-	// Add kudos to recipient
+	return nil
+}
+
+// transferKudos credits the recipient, updates the derived indexes, records history, emits
+// the transfer event and calls the AfterKudosSent hook; the quota and allowance must already
+// have been charged and the tip, if any, already transferred
+func (k Keeper) transferKudos(ctx sdk.Context, fromAddress, toAddress string, amount uint64, comment string, categoryID uint64, tip sdk.Coins) error {
+	// Add kudos to recipient; the lifetime total is what the balance would be without decay
 	k.AddKudos(ctx, toAddress, amount)
+	k.SetKudosLifetimeTotal(ctx, toAddress, k.GetKudosLifetimeTotal(ctx, toAddress)+amount)
+
+	// Track the per-category balance
+	if categoryID != 0 {
+		k.SetCategoryBalance(ctx, categoryID, toAddress, k.GetCategoryBalance(ctx, categoryID, toAddress)+amount)
+	}
+
+	// Credit today's bucket for windowed leaderboards
+	k.addPeriodAmount(ctx, toAddress, amount)
+
+	// Track lifetime total sent for the giver leaderboard
+	k.SetKudosSentTotal(ctx, fromAddress, k.GetKudosSentTotal(ctx, fromAddress)+amount)
 
 	// Add to history
-	k.AddKudosHistory(ctx, fromAddress, toAddress, amount, comment)
-
-	// Emit event
-	ctx.EventManager().EmitEvent(
-		sdk.NewEvent(
-			types.ModuleName,
-			sdk.NewAttribute("action", "send_kudos"),
-			sdk.NewAttribute("from", fromAddress),
-			sdk.NewAttribute("to", toAddress),
-			sdk.NewAttribute("amount", fmt.Sprintf("%d", amount)),
-		),
+	history := k.AddKudosHistory(ctx, fromAddress, toAddress, amount, comment, categoryID, tip)
+
+	// Emit the legacy event; deprecated in favour of EventKudosSent and kept for existing indexers
+	event := sdk.NewEvent(
+		types.ModuleName,
+		sdk.NewAttribute("action", "send_kudos"),
+		sdk.NewAttribute("from", fromAddress),
+		sdk.NewAttribute("to", toAddress),
+		sdk.NewAttribute("amount", fmt.Sprintf("%d", amount)),
 	)
+	if categoryID != 0 {
+		event = event.AppendAttributes(sdk.NewAttribute("category", fmt.Sprintf("%d", categoryID)))
+	}
+	if !tip.Empty() {
+		event = event.AppendAttributes(sdk.NewAttribute("tip", tip.String()))
+	}
+	ctx.EventManager().EmitEvent(event)
+
+	used, _ := k.getDailyUsage(ctx, fromAddress)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventKudosSent{
+		HistoryId:      history.Id,
+		FromAddress:    fromAddress,
+		ToAddress:      toAddress,
+		Amount:         amount,
+		CategoryId:     categoryID,
+		Comment:        comment,
+		Tip:            tip,
+		RemainingQuota: subFloor(k.GetParams(ctx).DailyLimit, used),
+	}); err != nil {
+		return err
+	}
 
-	return nil
+	return k.Hooks().AfterKudosSent(ctx, history)
 }
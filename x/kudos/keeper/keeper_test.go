@@ -1,9 +1,12 @@
 package keeper_test
 
 import (
+	"context"
+	"strings"
 	"testing"
 	"time"
 
+	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	"cosmossdk.io/store"
 	"cosmossdk.io/store/metrics"
@@ -14,6 +17,8 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/keeper"
@@ -22,24 +27,80 @@ import (
 
 // setupKeeper creates a keeper for testing
 func setupKeeper(t *testing.T) (keeper.Keeper, sdk.Context) {
+	k, ctx, _ := setupKeeperWithBank(t)
+	return k, ctx
+}
+
+// setupKeeperWithBank creates a keeper for testing along with the mock bank keeper it transfers tips through
+func setupKeeperWithBank(t *testing.T) (keeper.Keeper, sdk.Context, *mockBankKeeper) {
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tStoreKey := storetypes.NewTransientStoreKey(types.TStoreKey)
 	db := dbm.NewMemDB()
 	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
 	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	stateStore.MountStoreWithDB(tStoreKey, storetypes.StoreTypeTransient, db)
 	require.NoError(t, stateStore.LoadLatestVersion())
 
 	registry := codectypes.NewInterfaceRegistry()
 	cdc := codec.NewProtoCodec(registry)
+	bankKeeper := &mockBankKeeper{balances: map[string]sdk.Coins{}, blocked: map[string]bool{}}
 
 	k := keeper.NewKeeper(
 		cdc,
 		runtime.NewKVStoreService(storeKey),
+		tStoreKey,
 		log.NewNopLogger(),
+		bankKeeper,
+		authtypes.NewModuleAddress("gov").String(),
 	)
 
 	ctx := sdk.NewContext(stateStore, cmtproto.Header{Time: time.Now()}, false, log.NewNopLogger())
+	k.SetParams(ctx, types.DefaultParams())
 
-	return k, ctx
+	return k, ctx, bankKeeper
+}
+
+// mockBankKeeper is an in-memory types.BankKeeper
+type mockBankKeeper struct {
+	balances map[string]sdk.Coins
+	blocked  map[string]bool
+}
+
+func (b *mockBankKeeper) SendCoins(_ context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	balance, negative := b.balances[fromAddr.String()].SafeSub(amt...)
+	if negative {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "%s is smaller than %s", b.balances[fromAddr.String()], amt)
+	}
+	b.balances[fromAddr.String()] = balance
+	b.balances[toAddr.String()] = b.balances[toAddr.String()].Add(amt...)
+	return nil
+}
+
+func (b *mockBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return b.SendCoins(ctx, senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (b *mockBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	if b.BlockedAddr(recipientAddr) {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", recipientAddr)
+	}
+	return b.SendCoins(ctx, authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+}
+
+func (b *mockBankKeeper) GetBalance(_ context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	return sdk.NewCoin(denom, b.balances[addr.String()].AmountOf(denom))
+}
+
+func (b *mockBankKeeper) GetAllBalances(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.balances[addr.String()]
+}
+
+func (b *mockBankKeeper) BlockedAddr(addr sdk.AccAddress) bool {
+	return b.blocked[addr.String()]
+}
+
+func (b *mockBankKeeper) SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.GetAllBalances(ctx, addr)
 }
 
 func TestGetSetKudosBalance(t *testing.T) {
@@ -80,7 +141,7 @@ func TestSendKudos(t *testing.T) {
 	toAddr := "cosmos1to"
 
 	// Send kudos
-	err := k.SendKudos(ctx, fromAddr, toAddr, 100, "Great work!")
+	err := k.SendKudos(ctx, fromAddr, toAddr, 100, "Great work!", 0, nil)
 	require.NoError(t, err)
 
 	// Check recipient balance
@@ -98,10 +159,10 @@ func TestDailyQuotaEnforcement(t *testing.T) {
 	fromAddr := "cosmos1from"
 	toAddr := "cosmos1to"
 
-	err := k.SendKudos(ctx, fromAddr, toAddr, types.DefaultDailyLimit, "using up quota")
+	err := k.SendKudos(ctx, fromAddr, toAddr, types.DefaultDailyLimit, "using up quota", 0, nil)
 	require.NoError(t, err)
 
-	err = k.SendKudos(ctx, fromAddr, "cosmos1overflow", 1, "should exceed")
+	err = k.SendKudos(ctx, fromAddr, "cosmos1overflow", 1, "should exceed", 0, nil)
 	require.ErrorIs(t, err, types.ErrDailyLimitExceeded)
 
 	quota := k.GetDailyQuota(ctx, fromAddr)
@@ -115,24 +176,61 @@ func TestDailyQuotaResetAfterWindow(t *testing.T) {
 	fromAddr := "cosmos1from"
 	toAddr := "cosmos1to"
 
-	require.NoError(t, k.SendKudos(ctx, fromAddr, toAddr, 10, "first"))
+	require.NoError(t, k.SendKudos(ctx, fromAddr, toAddr, 10, "first", 0, nil))
 
 	ctx = ctx.WithBlockHeader(cmtproto.Header{Time: ctx.BlockTime().Add(time.Duration(types.DailyQuotaWindowSeconds+3600) * time.Second)})
 
-	require.NoError(t, k.SendKudos(ctx, fromAddr, toAddr, types.DefaultDailyLimit, "after reset"))
+	require.NoError(t, k.SendKudos(ctx, fromAddr, toAddr, types.DefaultDailyLimit, "after reset", 0, nil))
 
 	quota := k.GetDailyQuota(ctx, fromAddr)
 	require.Equal(t, types.DefaultDailyLimit, quota.Used)
 	require.Equal(t, uint64(0), quota.Remaining)
 }
 
+func TestDailyQuotaUsesParams(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	fromAddr := "cosmos1from"
+	toAddr := "cosmos1to"
+
+	params := types.DefaultParams()
+	params.DailyLimit = 20
+	params.QuotaWindowSeconds = 3600
+	k.SetParams(ctx, params)
+
+	require.NoError(t, k.SendKudos(ctx, fromAddr, toAddr, 20, "whole quota", 0, nil))
+	require.ErrorIs(t, k.SendKudos(ctx, fromAddr, toAddr, 1, "over quota", 0, nil), types.ErrDailyLimitExceeded)
+
+	quota := k.GetDailyQuota(ctx, fromAddr)
+	require.Equal(t, uint64(20), quota.Limit)
+	require.Equal(t, ctx.BlockTime().Unix()+3600, quota.ResetAt)
+
+	// Raising the limit applies to the current window immediately
+	params.DailyLimit = 30
+	k.SetParams(ctx, params)
+	require.NoError(t, k.SendKudos(ctx, fromAddr, toAddr, 10, "after raise", 0, nil))
+}
+
+func TestSendKudosCommentTooLong(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	comment := strings.Repeat("a", int(types.DefaultMaxCommentLength)+1)
+	err := k.SendKudos(ctx, "cosmos1from", "cosmos1to", 1, comment, 0, nil)
+	require.ErrorIs(t, err, types.ErrCommentTooLong)
+
+	params := types.DefaultParams()
+	params.MaxCommentLength = 200
+	k.SetParams(ctx, params)
+	require.NoError(t, k.SendKudos(ctx, "cosmos1from", "cosmos1to", 1, comment, 0, nil))
+}
+
 func TestSendKudosToSelf(t *testing.T) {
 	k, ctx := setupKeeper(t)
 
 	address := "cosmos1test"
 
 	// Try to send kudos to self
-	err := k.SendKudos(ctx, address, address, 100, "Self kudos")
+	err := k.SendKudos(ctx, address, address, 100, "Self kudos", 0, nil)
 	require.Error(t, err)
 	require.Equal(t, types.ErrSameAddress, err)
 }
@@ -144,7 +242,7 @@ func TestSendKudosZeroAmount(t *testing.T) {
 	toAddr := "cosmos1to"
 
 	// Try to send zero kudos
-	err := k.SendKudos(ctx, fromAddr, toAddr, 0, "Zero kudos")
+	err := k.SendKudos(ctx, fromAddr, toAddr, 0, "Zero kudos", 0, nil)
 	require.Error(t, err)
 	require.Equal(t, types.ErrInvalidAmount, err)
 }
@@ -184,3 +282,421 @@ func TestGetLeaderboardWithLimit(t *testing.T) {
 	require.Equal(t, "cosmos1addr2", leaderboard[0].Address)
 	require.Equal(t, "cosmos1addr1", leaderboard[1].Address)
 }
+
+func TestLeaderboardIndexFollowsBalanceUpdates(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	k.SetKudosBalance(ctx, "cosmos1addr1", 100)
+	k.SetKudosBalance(ctx, "cosmos1addr2", 50)
+
+	// Overtaking moves the address up without leaving a stale entry behind
+	k.AddKudos(ctx, "cosmos1addr2", 60)
+	leaderboard := k.GetLeaderboard(ctx, 0)
+	require.Equal(t, []types.LeaderboardEntry{
+		{Address: "cosmos1addr2", Balance: 110, Rank: 1},
+		{Address: "cosmos1addr1", Balance: 100, Rank: 2},
+	}, leaderboard)
+
+	// A zero balance drops out of the leaderboard
+	k.SetKudosBalance(ctx, "cosmos1addr1", 0)
+	leaderboard = k.GetLeaderboard(ctx, 0)
+	require.Len(t, leaderboard, 1)
+	require.Equal(t, "cosmos1addr2", leaderboard[0].Address)
+}
+
+func TestGetLeaderboardTiesOrderedByAddress(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	k.SetKudosBalance(ctx, "cosmos1ccc", 10)
+	k.SetKudosBalance(ctx, "cosmos1aaa", 10)
+	k.SetKudosBalance(ctx, "cosmos1bbb", 10)
+
+	leaderboard := k.GetLeaderboard(ctx, 2)
+	require.Equal(t, []types.LeaderboardEntry{
+		{Address: "cosmos1aaa", Balance: 10, Rank: 1},
+		{Address: "cosmos1bbb", Balance: 10, Rank: 2},
+	}, leaderboard)
+}
+
+func TestSendKudosTracksSentTotal(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1bob", 10, "", 0, nil))
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1carol", 5, "", 0, nil))
+	require.NoError(t, k.SendKudos(ctx, "cosmos1bob", "cosmos1alice", 20, "", 0, nil))
+
+	require.Equal(t, uint64(15), k.GetKudosSentTotal(ctx, "cosmos1alice"))
+	require.Equal(t, uint64(20), k.GetKudosSentTotal(ctx, "cosmos1bob"))
+	require.Equal(t, uint64(0), k.GetKudosSentTotal(ctx, "cosmos1carol"))
+
+	givers := k.GetGiverLeaderboard(ctx, 10)
+	require.Equal(t, []types.LeaderboardEntry{
+		{Address: "cosmos1bob", Balance: 20, Rank: 1},
+		{Address: "cosmos1alice", Balance: 15, Rank: 2},
+	}, givers)
+}
+
+func TestSendKudosWithCategory(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	k.SetCategory(ctx, types.NewCategory(1, "code-review", "", true))
+	k.SetCategory(ctx, types.NewCategory(2, "on-call", "", true))
+
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1bob", 10, "", 1, nil))
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1carol", 15, "", 1, nil))
+	require.NoError(t, k.SendKudos(ctx, "cosmos1carol", "cosmos1bob", 7, "", 2, nil))
+	require.NoError(t, k.SendKudos(ctx, "cosmos1carol", "cosmos1bob", 3, "", 0, nil))
+
+	require.Equal(t, uint64(20), k.GetKudosBalance(ctx, "cosmos1bob"))
+	require.Equal(t, uint64(10), k.GetCategoryBalance(ctx, 1, "cosmos1bob"))
+	require.Equal(t, uint64(7), k.GetCategoryBalance(ctx, 2, "cosmos1bob"))
+
+	require.Equal(t, []types.LeaderboardEntry{
+		{Address: "cosmos1carol", Balance: 15, Rank: 1},
+		{Address: "cosmos1bob", Balance: 10, Rank: 2},
+	}, k.GetCategoryLeaderboard(ctx, 1, 10))
+
+	history, found := k.GetKudosHistory(ctx, 3)
+	require.True(t, found)
+	require.Equal(t, uint64(2), history.CategoryId)
+
+	err := k.SendKudos(ctx, "cosmos1alice", "cosmos1bob", 1, "", 9, nil)
+	require.ErrorIs(t, err, types.ErrInvalidCategory)
+	require.Equal(t, uint64(20), k.GetKudosBalance(ctx, "cosmos1bob"))
+}
+
+func TestApplyDecay(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	params := types.DefaultParams()
+	params.DecayPercent = 50
+	params.DecayEpochSeconds = 3600
+	params.DecayAccountsPerBlock = 2
+	k.SetParams(ctx, params)
+
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1bob", 10, "", 0, nil))
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1carol", 20, "", 0, nil))
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1dave", 31, "", 0, nil))
+
+	// Enabling decay only records the running epoch, so nothing decays until the next one
+	k.ApplyDecay(ctx)
+	epoch := uint64(ctx.BlockTime().Unix()) / params.DecayEpochSeconds
+	require.Equal(t, types.DecayState{Epoch: epoch}, k.GetDecayState(ctx))
+	require.Equal(t, uint64(10), k.GetKudosBalance(ctx, "cosmos1bob"))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+	k.ApplyDecay(ctx)
+	require.Equal(t, uint64(10), k.GetKudosBalance(ctx, "cosmos1bob"))
+
+	// The first block of the next epoch starts a pass that decays at most two balances per block
+	ctx = ctx.WithBlockTime(time.Unix(int64((epoch+1)*params.DecayEpochSeconds), 0))
+	k.ApplyDecay(ctx)
+	require.Equal(t, uint64(5), k.GetKudosBalance(ctx, "cosmos1bob"))
+	require.Equal(t, uint64(10), k.GetKudosBalance(ctx, "cosmos1carol"))
+	require.Equal(t, uint64(31), k.GetKudosBalance(ctx, "cosmos1dave"))
+	require.True(t, k.GetDecayState(ctx).InProgress)
+
+	k.ApplyDecay(ctx)
+	require.Equal(t, uint64(15), k.GetKudosBalance(ctx, "cosmos1dave"))
+	require.False(t, k.GetDecayState(ctx).InProgress)
+
+	// Nothing more decays until the next epoch
+	k.ApplyDecay(ctx)
+	require.Equal(t, uint64(5), k.GetKudosBalance(ctx, "cosmos1bob"))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	k.ApplyDecay(ctx)
+	require.Equal(t, uint64(2), k.GetKudosBalance(ctx, "cosmos1bob"))
+	require.Equal(t, uint64(5), k.GetKudosBalance(ctx, "cosmos1carol"))
+
+	// Lifetime totals are unaffected and the leaderboard follows the decayed balances
+	require.Equal(t, uint64(10), k.GetKudosLifetimeTotal(ctx, "cosmos1bob"))
+	require.Equal(t, uint64(31), k.GetKudosLifetimeTotal(ctx, "cosmos1dave"))
+	require.Equal(t, []types.LeaderboardEntry{
+		{Address: "cosmos1dave", Balance: 15, Rank: 1},
+		{Address: "cosmos1carol", Balance: 5, Rank: 2},
+		{Address: "cosmos1bob", Balance: 2, Rank: 3},
+	}, k.GetLeaderboard(ctx, 0))
+
+	res, err := k.KudosBalance(ctx, &types.QueryKudosBalanceRequest{Address: "cosmos1carol"})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryKudosBalanceResponse{Balance: 5, LifetimeTotal: 20}, res)
+}
+
+func TestApplyDecayDisabled(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1bob", 10, "", 0, nil))
+
+	k.ApplyDecay(ctx)
+	require.Equal(t, uint64(10), k.GetKudosBalance(ctx, "cosmos1bob"))
+	require.Equal(t, types.DecayState{}, k.GetDecayState(ctx))
+}
+
+func TestApplySeasonRollover(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	// Seasons are disabled by default
+	k.ApplySeasonRollover(ctx)
+	_, found := k.GetCurrentSeason(ctx)
+	require.False(t, found)
+
+	params := types.DefaultParams()
+	params.SeasonLengthSeconds = 3600
+	k.SetParams(ctx, params)
+
+	start := ctx.BlockTime().Unix()
+	k.ApplySeasonRollover(ctx)
+	season, found := k.GetCurrentSeason(ctx)
+	require.True(t, found)
+	require.Equal(t, types.Season{Id: 1, StartTime: start, EndTime: start + 3600}, season)
+
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1bob", 10, "", 0, nil))
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1carol", 20, "", 0, nil))
+
+	// Nothing happens before the season ends
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(59 * time.Minute))
+	k.ApplySeasonRollover(ctx)
+	require.Equal(t, uint64(10), k.GetKudosBalance(ctx, "cosmos1bob"))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	k.ApplySeasonRollover(ctx)
+
+	archived, found := k.GetSeason(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, season, archived)
+
+	var standings []types.LeaderboardEntry
+	k.IterateSeasonStandings(ctx, func(seasonID uint64, entry types.LeaderboardEntry) bool {
+		require.Equal(t, uint64(1), seasonID)
+		standings = append(standings, entry)
+		return false
+	})
+	require.Equal(t, []types.LeaderboardEntry{
+		{Address: "cosmos1carol", Balance: 20, Rank: 1},
+		{Address: "cosmos1bob", Balance: 10, Rank: 2},
+	}, standings)
+
+	// Live balances are reset while lifetime totals are kept
+	require.Equal(t, uint64(0), k.GetKudosBalance(ctx, "cosmos1bob"))
+	require.Equal(t, uint64(10), k.GetKudosLifetimeTotal(ctx, "cosmos1bob"))
+	require.Empty(t, k.GetLeaderboard(ctx, 0))
+
+	season, found = k.GetCurrentSeason(ctx)
+	require.True(t, found)
+	require.Equal(t, uint64(2), season.Id)
+	require.Equal(t, ctx.BlockTime().Unix(), season.StartTime)
+
+	// Disabling seasons lets the running season end without starting a new one
+	params.SeasonLengthSeconds = 0
+	k.SetParams(ctx, params)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	k.ApplySeasonRollover(ctx)
+	_, found = k.GetCurrentSeason(ctx)
+	require.False(t, found)
+	_, found = k.GetSeason(ctx, 2)
+	require.True(t, found)
+}
+
+func TestApplySeasonRolloverInBatches(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	params := types.DefaultParams()
+	params.SeasonLengthSeconds = 3600
+	params.SeasonAccountsPerBlock = 2
+	params.RevokeGracePeriodSeconds = 2 * 3600
+	params.DecayPercent = 50
+	params.DecayEpochSeconds = 60
+	k.SetParams(ctx, params)
+	k.ApplySeasonRollover(ctx)
+
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1bob", 10, "", 0, nil))
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1carol", 30, "", 0, nil))
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1dave", 20, "", 0, nil))
+	k.SetDecayState(ctx, types.DecayState{Epoch: uint64(ctx.BlockTime().Unix()+3600) / 60})
+
+	// The first block archives the top two and freezes the remaining balances
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	k.ApplySeasonRollover(ctx)
+	_, found := k.GetCurrentSeason(ctx)
+	require.False(t, found)
+	require.Equal(t, types.SeasonRolloverState{SeasonId: 1, Archived: 2}, k.GetSeasonRolloverState(ctx))
+	require.Equal(t, uint64(0), k.GetKudosBalance(ctx, "cosmos1carol"))
+	require.Equal(t, uint64(10), k.GetKudosBalance(ctx, "cosmos1bob"))
+
+	err := k.SendKudos(ctx, "cosmos1alice", "cosmos1bob", 1, "", 0, nil)
+	require.ErrorIs(t, err, types.ErrSeasonRollover)
+	err = k.MultiSendKudos(ctx, "cosmos1alice", []types.KudosOutput{{ToAddress: "cosmos1bob", Amount: 1}})
+	require.ErrorIs(t, err, types.ErrSeasonRollover)
+	err = k.RevokeKudos(ctx, "cosmos1alice", 1)
+	require.ErrorIs(t, err, types.ErrSeasonRollover)
+
+	// Decay waits for the archival even though its epoch has started
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	k.ApplyDecay(ctx)
+	require.Equal(t, uint64(10), k.GetKudosBalance(ctx, "cosmos1bob"))
+
+	// The second block archives the rest and starts the next season
+	k.ApplySeasonRollover(ctx)
+	require.Equal(t, types.SeasonRolloverState{}, k.GetSeasonRolloverState(ctx))
+	require.Empty(t, k.GetLeaderboard(ctx, 0))
+	season, found := k.GetCurrentSeason(ctx)
+	require.True(t, found)
+	require.Equal(t, uint64(2), season.Id)
+	require.Equal(t, ctx.BlockTime().Unix(), season.StartTime)
+
+	var standings []types.LeaderboardEntry
+	k.IterateSeasonStandings(ctx, func(seasonID uint64, entry types.LeaderboardEntry) bool {
+		require.Equal(t, uint64(1), seasonID)
+		standings = append(standings, entry)
+		return false
+	})
+	require.Equal(t, []types.LeaderboardEntry{
+		{Address: "cosmos1carol", Balance: 30, Rank: 1},
+		{Address: "cosmos1dave", Balance: 20, Rank: 2},
+		{Address: "cosmos1bob", Balance: 10, Rank: 3},
+	}, standings)
+
+	// The season is reported as ended once, with every archived standing
+	var ended []*types.EventSeasonEnded
+	for _, event := range typedEvents(t, ctx) {
+		if e, ok := event.(*types.EventSeasonEnded); ok {
+			ended = append(ended, e)
+		}
+	}
+	require.Equal(t, []*types.EventSeasonEnded{{SeasonId: 1, Standings: 3}}, ended)
+
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1bob", 1, "", 0, nil))
+}
+
+func TestBudgetMode(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	params := types.DefaultParams()
+	params.BudgetEnabled = true
+	params.BudgetPerEpoch = 20
+	params.BudgetEpochSeconds = 3600
+	params.BudgetRolloverCap = 5
+	k.SetParams(ctx, params)
+
+	ctx = ctx.WithBlockTime(time.Unix(100*3600, 0))
+
+	// Sends draw down the allowance, which is checked before the quota is charged
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1bob", 12, "", 0, nil))
+	err := k.SendKudos(ctx, "cosmos1alice", "cosmos1bob", 9, "", 0, nil)
+	require.ErrorIs(t, err, types.ErrBudgetExceeded)
+	require.Equal(t, uint64(12), k.GetDailyQuota(ctx, "cosmos1alice").Used)
+
+	err = k.MultiSendKudos(ctx, "cosmos1alice", []types.KudosOutput{
+		{ToAddress: "cosmos1bob", Amount: 5},
+		{ToAddress: "cosmos1carol", Amount: 4},
+	})
+	require.ErrorIs(t, err, types.ErrBudgetExceeded)
+	require.NoError(t, k.MultiSendKudos(ctx, "cosmos1alice", []types.KudosOutput{
+		{ToAddress: "cosmos1bob", Amount: 3},
+		{ToAddress: "cosmos1carol", Amount: 3},
+	}))
+	require.Equal(t, uint64(2), k.GetGiveable(ctx, "cosmos1alice").Remaining)
+
+	// The received balance is separate from the giveable allowance
+	require.Equal(t, uint64(20), k.GetGiveable(ctx, "cosmos1bob").Remaining)
+
+	// Unspent allowance rolls over up to the cap
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	require.Equal(t, uint64(22), k.GetGiveable(ctx, "cosmos1alice").Remaining)
+	require.NoError(t, k.SendKudos(ctx, "cosmos1bob", "cosmos1alice", 1, "", 0, nil))
+	require.Equal(t, uint64(19), k.GetGiveable(ctx, "cosmos1bob").Remaining)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	require.Equal(t, uint64(25), k.GetGiveable(ctx, "cosmos1bob").Remaining)
+
+	// An address that skipped a whole epoch carries that epoch's allowance, capped
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(3 * time.Hour))
+	require.Equal(t, uint64(25), k.GetGiveable(ctx, "cosmos1alice").Remaining)
+
+	// Overrides replace the params allowance from the next refill
+	k.SetBudgetAllowance(ctx, "cosmos1carol", 0)
+	err = k.SendKudos(ctx, "cosmos1carol", "cosmos1bob", 1, "", 0, nil)
+	require.ErrorIs(t, err, types.ErrBudgetExceeded)
+	require.Equal(t, uint64(0), k.GetGiveable(ctx, "cosmos1carol").Allowance)
+
+	// Revoking a transfer from the running epoch refunds the allowance
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1bob", 10, "", 0, nil))
+	require.NoError(t, k.RevokeKudos(ctx, "cosmos1alice", 5))
+	require.Equal(t, uint64(25), k.GetGiveable(ctx, "cosmos1alice").Remaining)
+}
+
+func TestBudgetModeDisabled(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1bob", types.DefaultDailyLimit, "", 0, nil))
+	require.Equal(t, types.QueryGiveableBalanceResponse{}, k.GetGiveable(ctx, "cosmos1alice"))
+
+	_, found := k.GetGiveableBalance(ctx, "cosmos1alice")
+	require.False(t, found)
+}
+
+// mockKudosHooks records every hook call and rejects transfers to the blocked recipient
+type mockKudosHooks struct {
+	blocked string
+	sent    []types.KudosHistory
+	revoked []types.KudosHistory
+}
+
+func (h *mockKudosHooks) BeforeKudosSent(_ context.Context, _, toAddress string, _, _ uint64) error {
+	if toAddress == h.blocked {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s does not accept kudos", toAddress)
+	}
+	return nil
+}
+
+func (h *mockKudosHooks) AfterKudosSent(_ context.Context, history types.KudosHistory) error {
+	h.sent = append(h.sent, history)
+	return nil
+}
+
+func (h *mockKudosHooks) AfterKudosRevoked(_ context.Context, history types.KudosHistory) error {
+	h.revoked = append(h.revoked, history)
+	return nil
+}
+
+func TestKudosHooks(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	hooks := &mockKudosHooks{blocked: "cosmos1mallory"}
+	k.SetHooks(types.NewMultiKudosHooks(hooks))
+	require.Panics(t, func() { k.SetHooks(hooks) })
+
+	// A vetoed transfer leaves no trace
+	err := k.SendKudos(ctx, "cosmos1alice", "cosmos1mallory", 10, "", 0, nil)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.Zero(t, k.GetKudosBalance(ctx, "cosmos1mallory"))
+	require.Zero(t, k.GetHistoryCounter(ctx))
+	require.Zero(t, k.GetDailyQuota(ctx, "cosmos1alice").Used)
+
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1bob", 10, "thanks", 0, nil))
+	require.Len(t, hooks.sent, 1)
+	require.Equal(t, uint64(1), hooks.sent[0].Id)
+	require.Equal(t, "cosmos1bob", hooks.sent[0].ToAddress)
+	require.Equal(t, uint64(10), hooks.sent[0].Amount)
+
+	// A single vetoed output rejects the whole multi-send
+	err = k.MultiSendKudos(ctx, "cosmos1alice", []types.KudosOutput{
+		{ToAddress: "cosmos1bob", Amount: 1},
+		{ToAddress: "cosmos1mallory", Amount: 1},
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.Equal(t, uint64(10), k.GetKudosBalance(ctx, "cosmos1bob"))
+	require.Len(t, hooks.sent, 1)
+
+	require.NoError(t, k.MultiSendKudos(ctx, "cosmos1alice", []types.KudosOutput{
+		{ToAddress: "cosmos1bob", Amount: 1},
+		{ToAddress: "cosmos1carol", Amount: 2},
+	}))
+	require.Len(t, hooks.sent, 3)
+	require.Equal(t, "cosmos1carol", hooks.sent[2].ToAddress)
+
+	require.NoError(t, k.RevokeKudos(ctx, "cosmos1alice", 1))
+	require.Len(t, hooks.revoked, 1)
+	require.Equal(t, uint64(1), hooks.revoked[0].Id)
+	require.True(t, hooks.revoked[0].Revoked)
+}
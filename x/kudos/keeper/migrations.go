@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/pavlenkotm/cosmos-kudos-module/x/kudos/migrations/v2"
+	v3 "github.com/pavlenkotm/cosmos-kudos-module/x/kudos/migrations/v3"
+	v4 "github.com/pavlenkotm/cosmos-kudos-module/x/kudos/migrations/v4"
+	v5 "github.com/pavlenkotm/cosmos-kudos-module/x/kudos/migrations/v5"
+	v6 "github.com/pavlenkotm/cosmos-kudos-module/x/kudos/migrations/v6"
+	v7 "github.com/pavlenkotm/cosmos-kudos-module/x/kudos/migrations/v7"
+	v8 "github.com/pavlenkotm/cosmos-kudos-module/x/kudos/migrations/v8"
+	v9 "github.com/pavlenkotm/cosmos-kudos-module/x/kudos/migrations/v9"
+)
+
+// Migrator is a struct for handling in-place store migrations
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the store from consensus version 1 to 2
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
+}
+
+// Migrate2to3 migrates the store from consensus version 2 to 3
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.MigrateStore(ctx, m.keeper.storeService)
+}
+
+// Migrate3to4 migrates the store from consensus version 3 to 4
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	return v4.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
+}
+
+// Migrate4to5 migrates the store from consensus version 4 to 5
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	return v5.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
+}
+
+// Migrate5to6 migrates the store from consensus version 5 to 6
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	return v6.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
+}
+
+// Migrate6to7 migrates the store from consensus version 6 to 7
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	return v7.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
+}
+
+// Migrate7to8 migrates the store from consensus version 7 to 8
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	return v8.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
+}
+
+// Migrate8to9 migrates the store from consensus version 8 to 9
+func (m Migrator) Migrate8to9(ctx sdk.Context) error {
+	return v9.MigrateStore(ctx, m.keeper.storeService, m.keeper.cdc)
+}
@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
 )
@@ -20,12 +22,136 @@ var _ types.MsgServer = msgServer{}
 
 // SendKudos implements the SendKudos message handler
 func (k msgServer) SendKudos(goCtx context.Context, msg *types.MsgSendKudos) (*types.MsgSendKudosResponse, error) {
-	ctx := sdk.UnwrapSDKContext(goCtx)
+	ctx := k.withNextMsgIndex(sdk.UnwrapSDKContext(goCtx))
 
 	// Send kudos
-	if err := k.Keeper.SendKudos(ctx, msg.FromAddress, msg.ToAddress, msg.Amount, msg.Comment); err != nil {
+	if err := k.Keeper.SendKudos(ctx, msg.FromAddress, msg.ToAddress, msg.Amount, msg.Comment, msg.CategoryId, msg.Tip); err != nil {
 		return nil, err
 	}
 
 	return &types.MsgSendKudosResponse{}, nil
 }
+
+// MultiSendKudos implements the MultiSendKudos message handler
+func (k msgServer) MultiSendKudos(goCtx context.Context, msg *types.MsgMultiSendKudos) (*types.MsgMultiSendKudosResponse, error) {
+	ctx := k.withNextMsgIndex(sdk.UnwrapSDKContext(goCtx))
+
+	if err := k.Keeper.MultiSendKudos(ctx, msg.FromAddress, msg.Outputs); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMultiSendKudosResponse{}, nil
+}
+
+// UpdateParams implements the UpdateParams message handler
+func (k msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidParams, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetParams(ctx, msg.Params)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventParamsUpdated{Authority: msg.Authority, Params: msg.Params}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// SetCategory implements the SetCategory message handler
+func (k msgServer) SetCategory(goCtx context.Context, msg *types.MsgSetCategory) (*types.MsgSetCategoryResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Category.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.Keeper.SetCategory(ctx, msg.Category)
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventCategorySet{Category: msg.Category}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetCategoryResponse{}, nil
+}
+
+// RevokeKudos implements the RevokeKudos message handler
+func (k msgServer) RevokeKudos(goCtx context.Context, msg *types.MsgRevokeKudos) (*types.MsgRevokeKudosResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.RevokeKudos(ctx, msg.FromAddress, msg.HistoryId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRevokeKudosResponse{}, nil
+}
+
+// SetBudgetAllowance implements the SetBudgetAllowance message handler
+func (k msgServer) SetBudgetAllowance(goCtx context.Context, msg *types.MsgSetBudgetAllowance) (*types.MsgSetBudgetAllowanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	admin := k.GetParams(ctx).BudgetAdmin
+	if msg.Authority != k.authority && (admin == "" || msg.Authority != admin) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s or the budget admin, got %s", k.authority, msg.Authority)
+	}
+
+	if msg.Remove {
+		k.Keeper.DeleteBudgetAllowance(ctx, msg.Address)
+	} else {
+		k.Keeper.SetBudgetAllowance(ctx, msg.Address, msg.Allowance)
+	}
+
+	// Legacy event, deprecated in favour of EventBudgetAllowanceSet
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.ModuleName,
+			sdk.NewAttribute("action", "set_budget_allowance"),
+			sdk.NewAttribute("address", msg.Address),
+			sdk.NewAttribute("allowance", fmt.Sprintf("%d", msg.Allowance)),
+			sdk.NewAttribute("remove", fmt.Sprintf("%t", msg.Remove)),
+		),
+	)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventBudgetAllowanceSet{
+		Address:   msg.Address,
+		Allowance: msg.Allowance,
+		Remove:    msg.Remove,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetBudgetAllowanceResponse{}, nil
+}
+
+// FundRewardPool implements the FundRewardPool message handler
+func (k msgServer) FundRewardPool(goCtx context.Context, msg *types.MsgFundRewardPool) (*types.MsgFundRewardPoolResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.Keeper.FundRewardPool(ctx, msg.Depositor, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgFundRewardPoolResponse{}, nil
+}
+
+// RedeemKudos implements the RedeemKudos message handler
+func (k msgServer) RedeemKudos(goCtx context.Context, msg *types.MsgRedeemKudos) (*types.MsgRedeemKudosResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	redemption, err := k.Keeper.RedeemKudos(ctx, msg.Address, msg.Amount)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRedeemKudosResponse{
+		RedemptionId: redemption.Id,
+		Payout:       redemption.Payout,
+	}, nil
+}
@@ -0,0 +1,600 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/keeper"
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+func TestMsgUpdateParams(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	newParams := types.NewParams(50, 3600, 280, 25, 3600, 0, 0, 0, 0, 100, false, 0, 0, 0, "", nil, "", 0, 0, 0)
+
+	tests := []struct {
+		name      string
+		msg       *types.MsgUpdateParams
+		expectErr error
+	}{
+		{
+			name:      "wrong authority",
+			msg:       &types.MsgUpdateParams{Authority: "cosmos1notgov", Params: newParams},
+			expectErr: types.ErrInvalidSigner,
+		},
+		{
+			name: "invalid params",
+			msg: &types.MsgUpdateParams{
+				Authority: k.GetAuthority(),
+				Params:    types.NewParams(0, 3600, 280, 25, 3600, 0, 0, 0, 0, 100, false, 0, 0, 0, "", nil, "", 0, 0, 0),
+			},
+			expectErr: types.ErrInvalidParams,
+		},
+		{
+			name: "valid update",
+			msg:  &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: newParams},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := msgServer.UpdateParams(ctx, tt.msg)
+			if tt.expectErr != nil {
+				require.ErrorIs(t, err, tt.expectErr)
+				require.Equal(t, types.DefaultParams(), k.GetParams(ctx))
+				return
+			}
+
+			require.NoError(t, err)
+			require.Equal(t, newParams, k.GetParams(ctx))
+
+			res, err := k.Params(ctx, &types.QueryParamsRequest{})
+			require.NoError(t, err)
+			require.Equal(t, newParams, res.Params)
+		})
+	}
+}
+
+func TestMsgUpdateParamsLowersDailyLimit(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1bob", 30, "", 0, nil))
+	resetAt := k.GetDailyQuota(ctx, "cosmos1alice").ResetAt
+
+	// The usage is left alone; a sender above the new limit has nothing left in this window
+	params := k.GetParams(ctx)
+	params.DailyLimit = 20
+	_, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: params})
+	require.NoError(t, err)
+	require.Equal(t, types.QueryDailyQuotaResponse{Used: 30, Remaining: 0, Limit: 20, ResetAt: resetAt}, k.GetDailyQuota(ctx, "cosmos1alice"))
+	require.ErrorIs(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1bob", 1, "", 0, nil), types.ErrDailyLimitExceeded)
+
+	// The next window starts from the new limit
+	ctx = ctx.WithBlockTime(time.Unix(resetAt, 0))
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1bob", 20, "", 0, nil))
+	require.ErrorIs(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1bob", 1, "", 0, nil), types.ErrDailyLimitExceeded)
+}
+
+func TestSendKudosRecordsBlockMetadata(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	blockTime := time.Unix(1700000000, 0).UTC()
+	ctx = ctx.WithBlockHeight(42).WithBlockTime(blockTime).WithTxBytes([]byte("tx-one"))
+
+	for _, amount := range []uint64{1, 2} {
+		_, err := msgServer.SendKudos(ctx, &types.MsgSendKudos{FromAddress: "cosmos1from", ToAddress: "cosmos1to", Amount: amount})
+		require.NoError(t, err)
+	}
+
+	// A new transaction starts counting message indexes from zero again
+	ctx = ctx.WithTxBytes([]byte("tx-two"))
+	_, err := msgServer.SendKudos(ctx, &types.MsgSendKudos{FromAddress: "cosmos1from", ToAddress: "cosmos1to", Amount: 3})
+	require.NoError(t, err)
+
+	res, err := k.KudosHistory(ctx, &types.QueryKudosHistoryRequest{Pagination: &query.PageRequest{Reverse: true}})
+	require.NoError(t, err)
+	require.Len(t, res.History, 3)
+
+	txOneHash := fmt.Sprintf("%X", cmttypes.Tx("tx-one").Hash())
+	txTwoHash := fmt.Sprintf("%X", cmttypes.Tx("tx-two").Hash())
+
+	for i, expected := range []struct {
+		txHash   string
+		msgIndex uint32
+	}{{txOneHash, 0}, {txOneHash, 1}, {txTwoHash, 0}} {
+		entry := res.History[i]
+		require.Equal(t, blockTime.Unix(), entry.Timestamp)
+		require.Equal(t, int64(42), entry.BlockHeight)
+		require.Equal(t, expected.txHash, entry.TxHash)
+		require.Equal(t, expected.msgIndex, entry.KudosMsgIndex)
+		require.False(t, entry.Legacy)
+	}
+}
+
+func TestMsgMultiSendKudos(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	outputs := []types.KudosOutput{
+		{ToAddress: "cosmos1bob", Amount: 30, Comment: "retro MVP"},
+		{ToAddress: "cosmos1carol", Amount: 20},
+		{ToAddress: "cosmos1dave", Amount: 10, Comment: "on-call"},
+	}
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err := msgServer.MultiSendKudos(ctx, &types.MsgMultiSendKudos{FromAddress: "cosmos1alice", Outputs: outputs})
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(30), k.GetKudosBalance(ctx, "cosmos1bob"))
+	require.Equal(t, uint64(20), k.GetKudosBalance(ctx, "cosmos1carol"))
+	require.Equal(t, uint64(10), k.GetKudosBalance(ctx, "cosmos1dave"))
+	require.Equal(t, uint64(60), k.GetKudosSentTotal(ctx, "cosmos1alice"))
+	require.Equal(t, uint64(60), k.GetDailyQuota(ctx, "cosmos1alice").Used)
+	require.Equal(t, uint64(3), k.GetHistoryCounter(ctx))
+	require.Len(t, legacyEvents(ctx), 3)
+
+	// The total exceeds the remaining quota, so nothing is applied
+	_, err = msgServer.MultiSendKudos(ctx, &types.MsgMultiSendKudos{FromAddress: "cosmos1alice", Outputs: outputs})
+	require.ErrorIs(t, err, types.ErrDailyLimitExceeded)
+	require.Equal(t, uint64(30), k.GetKudosBalance(ctx, "cosmos1bob"))
+	require.Equal(t, uint64(60), k.GetDailyQuota(ctx, "cosmos1alice").Used)
+	require.Equal(t, uint64(3), k.GetHistoryCounter(ctx))
+
+	// An invalid output rejects the whole message before any transfer
+	_, err = msgServer.MultiSendKudos(ctx, &types.MsgMultiSendKudos{
+		FromAddress: "cosmos1erin",
+		Outputs: []types.KudosOutput{
+			{ToAddress: "cosmos1bob", Amount: 1},
+			{ToAddress: "cosmos1erin", Amount: 1},
+		},
+	})
+	require.ErrorIs(t, err, types.ErrSameAddress)
+	require.Equal(t, uint64(30), k.GetKudosBalance(ctx, "cosmos1bob"))
+	require.Equal(t, uint64(0), k.GetDailyQuota(ctx, "cosmos1erin").Used)
+}
+
+func TestMsgSetCategory(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	category := types.NewCategory(1, "code-review", "Thorough and helpful reviews", true)
+
+	_, err := msgServer.SetCategory(ctx, &types.MsgSetCategory{Authority: "cosmos1notgov", Category: category})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	_, err = msgServer.SetCategory(ctx, &types.MsgSetCategory{Authority: k.GetAuthority(), Category: types.NewCategory(0, "none", "", true)})
+	require.ErrorIs(t, err, types.ErrInvalidCategory)
+
+	_, err = msgServer.SetCategory(ctx, &types.MsgSetCategory{Authority: k.GetAuthority(), Category: category})
+	require.NoError(t, err)
+
+	stored, found := k.GetCategory(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, category, stored)
+
+	_, err = msgServer.SendKudos(ctx, &types.MsgSendKudos{FromAddress: "cosmos1from", ToAddress: "cosmos1to", Amount: 2, CategoryId: 1})
+	require.NoError(t, err)
+
+	// Retiring a category rejects new kudos but keeps existing balances
+	category.Active = false
+	_, err = msgServer.SetCategory(ctx, &types.MsgSetCategory{Authority: k.GetAuthority(), Category: category})
+	require.NoError(t, err)
+
+	_, err = msgServer.SendKudos(ctx, &types.MsgSendKudos{FromAddress: "cosmos1from", ToAddress: "cosmos1to", Amount: 2, CategoryId: 1})
+	require.ErrorIs(t, err, types.ErrInvalidCategory)
+	require.Equal(t, uint64(2), k.GetCategoryBalance(ctx, 1, "cosmos1to"))
+}
+
+func TestMsgRevokeKudos(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	k.SetCategory(ctx, types.NewCategory(1, "code-review", "", true))
+
+	_, err := msgServer.SendKudos(ctx, &types.MsgSendKudos{FromAddress: "cosmos1alice", ToAddress: "cosmos1bob", Amount: 10, CategoryId: 1})
+	require.NoError(t, err)
+	_, err = msgServer.SendKudos(ctx, &types.MsgSendKudos{FromAddress: "cosmos1alice", ToAddress: "cosmos1bob", Amount: 5})
+	require.NoError(t, err)
+
+	// Only the original sender may revoke
+	_, err = msgServer.RevokeKudos(ctx, &types.MsgRevokeKudos{FromAddress: "cosmos1bob", HistoryId: 1})
+	require.ErrorIs(t, err, types.ErrNotSender)
+
+	_, err = msgServer.RevokeKudos(ctx, &types.MsgRevokeKudos{FromAddress: "cosmos1alice", HistoryId: 99})
+	require.ErrorIs(t, err, types.ErrHistoryNotFound)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute)).WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.RevokeKudos(ctx, &types.MsgRevokeKudos{FromAddress: "cosmos1alice", HistoryId: 1})
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(5), k.GetKudosBalance(ctx, "cosmos1bob"))
+	require.Equal(t, uint64(0), k.GetCategoryBalance(ctx, 1, "cosmos1bob"))
+	require.Equal(t, uint64(5), k.GetKudosSentTotal(ctx, "cosmos1alice"))
+	require.Equal(t, uint64(5), k.GetPeriodAmount(ctx, types.PeriodDay(ctx.BlockTime()), "cosmos1bob"))
+	require.Equal(t, uint64(5), k.GetDailyQuota(ctx, "cosmos1alice").Used)
+	require.Equal(t, []types.LeaderboardEntry{{Address: "cosmos1bob", Balance: 5, Rank: 1}}, k.GetLeaderboard(ctx, 0))
+	require.Empty(t, k.GetCategoryLeaderboard(ctx, 1, 0))
+
+	history, found := k.GetKudosHistory(ctx, 1)
+	require.True(t, found)
+	require.True(t, history.Revoked)
+	require.Equal(t, ctx.BlockTime().Unix(), history.RevokedAt)
+
+	events := legacyEvents(ctx)
+	require.Len(t, events, 1)
+	require.Equal(t, "revoke_kudos", events[0].Attributes[0].Value)
+
+	_, err = msgServer.RevokeKudos(ctx, &types.MsgRevokeKudos{FromAddress: "cosmos1alice", HistoryId: 1})
+	require.ErrorIs(t, err, types.ErrAlreadyRevoked)
+
+	// Once the grace period has passed the transfer is final
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Duration(types.DefaultRevokeGracePeriodSeconds) * time.Second))
+	_, err = msgServer.RevokeKudos(ctx, &types.MsgRevokeKudos{FromAddress: "cosmos1alice", HistoryId: 2})
+	require.ErrorIs(t, err, types.ErrRevokeExpired)
+	require.Equal(t, uint64(5), k.GetKudosBalance(ctx, "cosmos1bob"))
+}
+
+func TestRevokeKudosQuotaRefundOnlyInSameWindow(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	params := types.DefaultParams()
+	params.QuotaWindowSeconds = 60
+	k.SetParams(ctx, params)
+
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1bob", 10, "", 0, nil))
+
+	// The quota window rolled over and a new transfer opened the next one
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Minute))
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1carol", 3, "", 0, nil))
+
+	require.NoError(t, k.RevokeKudos(ctx, "cosmos1alice", 1))
+	require.Equal(t, uint64(3), k.GetDailyQuota(ctx, "cosmos1alice").Used)
+	require.Equal(t, uint64(0), k.GetKudosBalance(ctx, "cosmos1bob"))
+}
+
+func TestRevokeKudosFromEndedSeason(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	params := types.DefaultParams()
+	params.SeasonLengthSeconds = 3600
+	k.SetParams(ctx, params)
+	k.ApplySeasonRollover(ctx)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(59 * time.Minute))
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1bob", 30, "", 0, nil))
+
+	// The season ends within the grace period and bob starts the next one from zero
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	k.ApplySeasonRollover(ctx)
+	require.Equal(t, uint64(0), k.GetKudosBalance(ctx, "cosmos1bob"))
+	require.NoError(t, k.SendKudos(ctx, "cosmos1carol", "cosmos1bob", 40, "", 0, nil))
+
+	err := k.RevokeKudos(ctx, "cosmos1alice", 1)
+	require.ErrorIs(t, err, types.ErrRevokeExpired)
+	require.Equal(t, uint64(40), k.GetKudosBalance(ctx, "cosmos1bob"))
+	history, _ := k.GetKudosHistory(ctx, 1)
+	require.False(t, history.Revoked)
+
+	// Transfers of the running season can still be revoked
+	require.NoError(t, k.RevokeKudos(ctx, "cosmos1carol", 2))
+	require.Equal(t, uint64(0), k.GetKudosBalance(ctx, "cosmos1bob"))
+}
+
+func TestMsgSetBudgetAllowance(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	admin := sdk.AccAddress([]byte("budget_admin________")).String()
+
+	_, err := msgServer.SetBudgetAllowance(ctx, &types.MsgSetBudgetAllowance{Authority: admin, Address: "cosmos1bob", Allowance: 50})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	_, err = msgServer.SetBudgetAllowance(ctx, &types.MsgSetBudgetAllowance{Authority: k.GetAuthority(), Address: "cosmos1bob", Allowance: 50})
+	require.NoError(t, err)
+	allowance, found := k.GetBudgetAllowance(ctx, "cosmos1bob")
+	require.True(t, found)
+	require.Equal(t, uint64(50), allowance)
+
+	// Once configured the budget admin may manage allowances as well
+	params := types.DefaultParams()
+	params.BudgetAdmin = admin
+	k.SetParams(ctx, params)
+
+	_, err = msgServer.SetBudgetAllowance(ctx, &types.MsgSetBudgetAllowance{Authority: admin, Address: "cosmos1bob", Allowance: 70})
+	require.NoError(t, err)
+	allowance, _ = k.GetBudgetAllowance(ctx, "cosmos1bob")
+	require.Equal(t, uint64(70), allowance)
+
+	_, err = msgServer.SetBudgetAllowance(ctx, &types.MsgSetBudgetAllowance{Authority: admin, Address: "cosmos1bob", Remove: true})
+	require.NoError(t, err)
+	_, found = k.GetBudgetAllowance(ctx, "cosmos1bob")
+	require.False(t, found)
+}
+
+func TestMsgSendKudosWithTip(t *testing.T) {
+	k, ctx, bank := setupKeeperWithBank(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	alice := sdk.AccAddress([]byte("alice_______________")).String()
+	bob := sdk.AccAddress([]byte("bob_________________")).String()
+	tip := sdk.NewCoins(sdk.NewInt64Coin("stake", 30))
+	bank.balances[alice] = sdk.NewCoins(sdk.NewInt64Coin("stake", 100), sdk.NewInt64Coin("atom", 100))
+
+	// Tips are disabled until governance allows a denom
+	_, err := msgServer.SendKudos(ctx, &types.MsgSendKudos{FromAddress: alice, ToAddress: bob, Amount: 1, Tip: tip})
+	require.ErrorIs(t, err, types.ErrInvalidTip)
+
+	params := types.DefaultParams()
+	params.AllowedTipDenoms = []string{"stake"}
+	k.SetParams(ctx, params)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.SendKudos(ctx, &types.MsgSendKudos{FromAddress: alice, ToAddress: bob, Amount: 5, Tip: tip})
+	require.NoError(t, err)
+
+	require.Equal(t, uint64(5), k.GetKudosBalance(ctx, bob))
+	require.Equal(t, tip, bank.balances[bob])
+	require.Equal(t, int64(70), bank.balances[alice].AmountOf("stake").Int64())
+
+	history, found := k.GetKudosHistory(ctx, 1)
+	require.True(t, found)
+	require.Equal(t, tip, history.Tip)
+
+	events := legacyEvents(ctx)
+	require.Len(t, events, 1)
+	tipAttr, found := events[0].GetAttribute("tip")
+	require.True(t, found)
+	require.Equal(t, "30stake", tipAttr.Value)
+
+	_, err = msgServer.SendKudos(ctx, &types.MsgSendKudos{FromAddress: alice, ToAddress: bob, Amount: 1, Tip: sdk.NewCoins(sdk.NewInt64Coin("atom", 1))})
+	require.ErrorIs(t, err, types.ErrInvalidTip)
+
+	_, err = msgServer.SendKudos(ctx, &types.MsgSendKudos{FromAddress: alice, ToAddress: bob, Amount: 1, Tip: sdk.NewCoins(sdk.NewInt64Coin("stake", 71))})
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	require.Equal(t, uint64(5), k.GetKudosBalance(ctx, bob))
+
+	bank.blocked[bob] = true
+	_, err = msgServer.SendKudos(ctx, &types.MsgSendKudos{FromAddress: alice, ToAddress: bob, Amount: 1, Tip: tip})
+	require.ErrorIs(t, err, types.ErrInvalidTip)
+
+	// Tipped transfers are final
+	_, err = msgServer.RevokeKudos(ctx, &types.MsgRevokeKudos{FromAddress: alice, HistoryId: 1})
+	require.ErrorIs(t, err, types.ErrInvalidTip)
+}
+
+func TestMsgRedeemKudos(t *testing.T) {
+	k, ctx, bank := setupKeeperWithBank(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	alice := sdk.AccAddress([]byte("alice_______________")).String()
+	bob := sdk.AccAddress([]byte("bob_________________")).String()
+	pool := k.RewardPoolAddress().String()
+	bank.balances[alice] = sdk.NewCoins(sdk.NewInt64Coin("stake", 1000), sdk.NewInt64Coin("atom", 10))
+	k.SetKudosBalance(ctx, bob, 50)
+
+	// Redemption is disabled until governance sets a redeem denom
+	_, err := msgServer.FundRewardPool(ctx, &types.MsgFundRewardPool{Depositor: alice, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))})
+	require.ErrorIs(t, err, types.ErrRedeemDisabled)
+	_, err = msgServer.RedeemKudos(ctx, &types.MsgRedeemKudos{Address: bob, Amount: 1})
+	require.ErrorIs(t, err, types.ErrRedeemDisabled)
+
+	params := types.DefaultParams()
+	params.RedeemDenom = "stake"
+	params.RedeemRate = 10
+	params.RedeemEpochSeconds = 3600
+	params.RedeemCapPerEpoch = 30
+	k.SetParams(ctx, params)
+	ctx = ctx.WithBlockTime(time.Unix(10*3600, 0))
+
+	_, err = msgServer.FundRewardPool(ctx, &types.MsgFundRewardPool{Depositor: alice, Amount: sdk.NewCoins(sdk.NewInt64Coin("atom", 10))})
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	_, err = msgServer.FundRewardPool(ctx, &types.MsgFundRewardPool{Depositor: alice, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 250))})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 250)), bank.balances[pool])
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res, err := msgServer.RedeemKudos(ctx, &types.MsgRedeemKudos{Address: bob, Amount: 20})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), res.RedemptionId)
+	require.Equal(t, sdk.NewInt64Coin("stake", 200), res.Payout)
+	require.Equal(t, uint64(30), k.GetKudosBalance(ctx, bob))
+	require.Equal(t, int64(200), bank.balances[bob].AmountOf("stake").Int64())
+	require.Equal(t, int64(50), bank.balances[pool].AmountOf("stake").Int64())
+
+	events := legacyEvents(ctx)
+	require.Len(t, events, 1)
+	payoutAttr, found := events[0].GetAttribute("payout")
+	require.True(t, found)
+	require.Equal(t, "200stake", payoutAttr.Value)
+
+	// The cap counts what was already redeemed this epoch
+	_, err = msgServer.RedeemKudos(ctx, &types.MsgRedeemKudos{Address: bob, Amount: 11})
+	require.ErrorIs(t, err, types.ErrRedeemCapExceeded)
+
+	// The pool only holds enough for 5 more kudos
+	_, err = msgServer.RedeemKudos(ctx, &types.MsgRedeemKudos{Address: bob, Amount: 6})
+	require.ErrorIs(t, err, types.ErrRewardPoolDepleted)
+	require.Equal(t, uint64(30), k.GetKudosBalance(ctx, bob))
+
+	_, err = msgServer.RedeemKudos(ctx, &types.MsgRedeemKudos{Address: alice, Amount: 1})
+	require.ErrorIs(t, err, types.ErrInsufficientKudos)
+
+	// A new epoch resets the cap
+	_, err = msgServer.FundRewardPool(ctx, &types.MsgFundRewardPool{Depositor: alice, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 750))})
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(time.Unix(11*3600, 0))
+	res, err = msgServer.RedeemKudos(ctx, &types.MsgRedeemKudos{Address: bob, Amount: 30})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), res.RedemptionId)
+	require.Equal(t, uint64(0), k.GetKudosBalance(ctx, bob))
+	require.Equal(t, int64(500), bank.balances[bob].AmountOf("stake").Int64())
+
+	// The redemption is recorded and the emptied balance drops off the leaderboard
+	redemption, found := k.GetRedemption(ctx, 2)
+	require.True(t, found)
+	require.Equal(t, types.Redemption{Id: 2, Address: bob, Amount: 30, Payout: sdk.NewInt64Coin("stake", 300), Timestamp: 11 * 3600}, redemption)
+	require.Empty(t, k.GetLeaderboard(ctx, 10))
+}
+
+func TestRevokeAfterRedeemDoesNotMintKudos(t *testing.T) {
+	k, ctx, bank := setupKeeperWithBank(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	alice := sdk.AccAddress([]byte("alice_______________")).String()
+	bob := sdk.AccAddress([]byte("bob_________________")).String()
+	pool := k.RewardPoolAddress().String()
+
+	params := types.DefaultParams()
+	params.RedeemDenom = "stake"
+	params.RedeemRate = 1
+	k.SetParams(ctx, params)
+	bank.balances[pool] = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+
+	// bob redeems the transfer within the grace period, then alice tries to revoke it
+	_, err := msgServer.SendKudos(ctx, &types.MsgSendKudos{FromAddress: alice, ToAddress: bob, Amount: 10})
+	require.NoError(t, err)
+	_, err = msgServer.RedeemKudos(ctx, &types.MsgRedeemKudos{Address: bob, Amount: 8})
+	require.NoError(t, err)
+
+	_, err = msgServer.RevokeKudos(ctx, &types.MsgRevokeKudos{FromAddress: alice, HistoryId: 1})
+	require.ErrorIs(t, err, types.ErrInsufficientKudos)
+
+	// Nothing was reversed, so alice cannot resend the kudos bob already cashed out
+	history, found := k.GetKudosHistory(ctx, 1)
+	require.True(t, found)
+	require.False(t, history.Revoked)
+	require.Equal(t, uint64(2), k.GetKudosBalance(ctx, bob))
+	require.Equal(t, uint64(10), k.GetKudosLifetimeTotal(ctx, bob))
+	require.Equal(t, uint64(10), k.GetDailyQuota(ctx, alice).Used)
+	require.Equal(t, int64(8), bank.balances[bob].AmountOf("stake").Int64())
+	require.NoError(t, keeper.AssertInvariants(ctx, k))
+
+	// A transfer the recipient still fully holds can be revoked
+	_, err = msgServer.SendKudos(ctx, &types.MsgSendKudos{FromAddress: alice, ToAddress: bob, Amount: 5})
+	require.NoError(t, err)
+	_, err = msgServer.RevokeKudos(ctx, &types.MsgRevokeKudos{FromAddress: alice, HistoryId: 2})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), k.GetKudosBalance(ctx, bob))
+}
+
+func TestTypedEvents(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+	k.SetCategory(ctx, types.NewCategory(1, "code-review", "", true))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err := msgServer.SendKudos(ctx, &types.MsgSendKudos{FromAddress: "cosmos1alice", ToAddress: "cosmos1bob", Amount: 10, Comment: "thanks", CategoryId: 1})
+	require.NoError(t, err)
+
+	// Opening the first quota window of an address is not a reset, so only the transfer is reported
+	require.Equal(t, []proto.Message{&types.EventKudosSent{
+		HistoryId:      1,
+		FromAddress:    "cosmos1alice",
+		ToAddress:      "cosmos1bob",
+		Amount:         10,
+		CategoryId:     1,
+		Comment:        "thanks",
+		Tip:            sdk.Coins{},
+		RemainingQuota: types.DefaultDailyLimit - 10,
+	}}, typedEvents(t, ctx))
+
+	// The legacy event is still emitted alongside the typed one
+	require.Len(t, legacyEvents(ctx), 1)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.RevokeKudos(ctx, &types.MsgRevokeKudos{FromAddress: "cosmos1alice", HistoryId: 1})
+	require.NoError(t, err)
+	require.Equal(t, []proto.Message{&types.EventKudosRevoked{
+		HistoryId:   1,
+		FromAddress: "cosmos1alice",
+		ToAddress:   "cosmos1bob",
+		Amount:      10,
+	}}, typedEvents(t, ctx))
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	params := types.DefaultParams()
+	params.DailyLimit = 50
+	params.AllowedTipDenoms = []string{"stake"}
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: params})
+	require.NoError(t, err)
+	require.Equal(t, []proto.Message{&types.EventParamsUpdated{Authority: k.GetAuthority(), Params: params}}, typedEvents(t, ctx))
+}
+
+func TestDailyQuotaResetEvent(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	_, err := msgServer.SendKudos(ctx, &types.MsgSendKudos{FromAddress: "cosmos1alice", ToAddress: "cosmos1bob", Amount: 10})
+	require.NoError(t, err)
+
+	// Querying an expired window reports the fresh one without rolling it over
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Duration(types.DailyQuotaWindowSeconds) * time.Second))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res, err := k.KudosDailyQuota(ctx, &types.QueryDailyQuotaRequest{Address: "cosmos1alice"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), res.Used)
+	require.Equal(t, types.DefaultDailyLimit, res.Remaining)
+	require.Equal(t, ctx.BlockTime().Unix()+int64(types.DailyQuotaWindowSeconds), res.ResetAt)
+	require.Empty(t, ctx.EventManager().Events())
+
+	// The next transfer starts the new window and reports what the expired one used
+	_, err = msgServer.SendKudos(ctx, &types.MsgSendKudos{FromAddress: "cosmos1alice", ToAddress: "cosmos1bob", Amount: 4})
+	require.NoError(t, err)
+	events := typedEvents(t, ctx)
+	require.Len(t, events, 2)
+	require.Equal(t, &types.EventDailyQuotaReset{
+		Address:      "cosmos1alice",
+		PreviousUsed: 10,
+		ResetAt:      ctx.BlockTime().Unix() + int64(types.DailyQuotaWindowSeconds),
+	}, events[0])
+
+	// A window left unused after a revocation refunded it expires silently
+	_, err = msgServer.RevokeKudos(ctx, &types.MsgRevokeKudos{FromAddress: "cosmos1alice", HistoryId: 2})
+	require.NoError(t, err)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Duration(types.DailyQuotaWindowSeconds) * time.Second))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = msgServer.SendKudos(ctx, &types.MsgSendKudos{FromAddress: "cosmos1alice", ToAddress: "cosmos1bob", Amount: 1})
+	require.NoError(t, err)
+	events = typedEvents(t, ctx)
+	require.Len(t, events, 1)
+	require.IsType(t, &types.EventKudosSent{}, events[0])
+}
+
+// legacyEvents returns the untyped events emitted under the module name
+func legacyEvents(ctx sdk.Context) sdk.Events {
+	var events sdk.Events
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.ModuleName {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+// typedEvents parses every typed event emitted so far
+func typedEvents(t *testing.T, ctx sdk.Context) []proto.Message {
+	var events []proto.Message
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.ModuleName {
+			continue
+		}
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		require.NoError(t, err)
+		events = append(events, msg)
+	}
+	return events
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+// GetParams returns the current module parameters
+func (k Keeper) GetParams(ctx sdk.Context) types.Params {
+	store := k.storeService.OpenKVStore(ctx)
+
+	bz, err := store.Get(types.ParamsKey)
+	if err != nil || bz == nil {
+		return types.Params{}
+	}
+
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+
+	return params
+}
+
+// SetParams sets the module parameters
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&params)
+
+	if err := store.Set(types.ParamsKey, bz); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"sort"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+// GetPeriodAmount returns the kudos an address received within a single day bucket
+func (k Keeper) GetPeriodAmount(ctx sdk.Context, day uint64, address string) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+
+	bz, err := store.Get(types.PeriodBucketKey(day, address))
+	if err != nil || bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetPeriodAmount sets the kudos an address received within a day bucket; zero removes the bucket
+func (k Keeper) SetPeriodAmount(ctx sdk.Context, day uint64, address string, amount uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	key := types.PeriodBucketKey(day, address)
+
+	if amount == 0 {
+		if err := store.Delete(key); err != nil {
+			panic(err)
+		}
+		return
+	}
+
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, amount)
+
+	if err := store.Set(key, bz); err != nil {
+		panic(err)
+	}
+}
+
+// addPeriodAmount credits a recipient's bucket for the day of the current block
+func (k Keeper) addPeriodAmount(ctx sdk.Context, address string, amount uint64) {
+	day := types.PeriodDay(ctx.BlockTime())
+	k.SetPeriodAmount(ctx, day, address, k.GetPeriodAmount(ctx, day, address)+amount)
+}
+
+// IteratePeriodBuckets iterates over the buckets of days startDay through endDay (inclusive)
+// in (day, address) order until cb returns true
+func (k Keeper) IteratePeriodBuckets(ctx sdk.Context, startDay, endDay uint64, cb func(day uint64, address string, amount uint64) (stop bool)) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	end := storetypes.PrefixEndBytes(types.PeriodBucketPrefix)
+	if endDay < ^uint64(0) {
+		end = types.PeriodBucketDayPrefix(endDay + 1)
+	}
+
+	iterator, err := store.Iterator(types.PeriodBucketDayPrefix(startDay), end)
+	if err != nil {
+		panic(err)
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		day, address := types.ParsePeriodBucketKey(iterator.Key()[len(types.PeriodBucketPrefix):])
+		if cb(day, address, binary.BigEndian.Uint64(iterator.Value())) {
+			break
+		}
+	}
+}
+
+// GetPeriodLeaderboard ranks the kudos received within days startDay through endDay (inclusive),
+// highest total first with ties ordered by address
+func (k Keeper) GetPeriodLeaderboard(ctx sdk.Context, startDay, endDay uint64) []types.LeaderboardEntry {
+	totals := make(map[string]uint64)
+	k.IteratePeriodBuckets(ctx, startDay, endDay, func(_ uint64, address string, amount uint64) bool {
+		totals[address] += amount
+		return false
+	})
+
+	entries := make([]types.LeaderboardEntry, 0, len(totals))
+	for address, total := range totals {
+		entries = append(entries, types.LeaderboardEntry{Address: address, Balance: total})
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Balance != entries[j].Balance {
+			return entries[i].Balance > entries[j].Balance
+		}
+		return entries[i].Address < entries[j].Address
+	})
+
+	for i := range entries {
+		entries[i].Rank = uint64(i + 1)
+	}
+
+	return entries
+}
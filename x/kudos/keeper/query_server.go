@@ -2,8 +2,14 @@ package keeper
 
 import (
 	"context"
+	"encoding/binary"
 
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
 	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
 )
 
@@ -17,11 +23,9 @@ func (k Keeper) KudosBalance(goCtx context.Context, req *types.QueryKudosBalance
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	// Get balance
-	balance := k.GetKudosBalance(ctx, req.Address)
-
 	return &types.QueryKudosBalanceResponse{
-		Balance: balance,
+		Balance:       k.GetKudosBalance(ctx, req.Address),
+		LifetimeTotal: k.GetKudosLifetimeTotal(ctx, req.Address),
 	}, nil
 }
 
@@ -36,17 +40,232 @@ func (k Keeper) KudosLeaderboard(goCtx context.Context, req *types.QueryKudosLea
 	// Default limit if not specified
 	limit := req.Limit
 	if limit == 0 {
-		limit = 10
+		limit = k.GetParams(ctx).DefaultLeaderboardLimit
 	}
 
-	// Get leaderboard
-	entries := k.GetLeaderboard(ctx, limit)
+	entries, pageRes, err := k.paginateRankedIndex(ctx, types.LeaderboardPrefix, limit, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
 
 	return &types.QueryKudosLeaderboardResponse{
-		Entries: entries,
+		Entries:    entries,
+		Pagination: pageRes,
+	}, nil
+}
+
+// GiverLeaderboard implements the Query/GiverLeaderboard gRPC method
+func (k Keeper) GiverLeaderboard(goCtx context.Context, req *types.QueryGiverLeaderboardRequest) (*types.QueryGiverLeaderboardResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidLeaderboard
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = k.GetParams(ctx).DefaultLeaderboardLimit
+	}
+
+	entries, pageRes, err := k.paginateRankedIndex(ctx, types.GiverLeaderboardPrefix, limit, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryGiverLeaderboardResponse{
+		Entries:    entries,
+		Pagination: pageRes,
+	}, nil
+}
+
+// PeriodLeaderboard implements the Query/PeriodLeaderboard gRPC method
+func (k Keeper) PeriodLeaderboard(goCtx context.Context, req *types.QueryPeriodLeaderboardRequest) (*types.QueryPeriodLeaderboardResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidLeaderboard
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	startDay, endDay, err := types.ResolvePeriod(req.Period, req.StartTime, req.EndTime, ctx.BlockTime())
+	if err != nil {
+		return nil, err
+	}
+
+	limit := uint64(req.Limit)
+	if limit == 0 {
+		limit = uint64(k.GetParams(ctx).DefaultLeaderboardLimit)
+	}
+
+	// Rankings are aggregated on the fly, so only offset pagination is available
+	var offset uint64
+	if req.Pagination != nil {
+		if len(req.Pagination.Key) > 0 || req.Pagination.Reverse {
+			return nil, errorsmod.Wrap(types.ErrInvalidLeaderboard, "only offset pagination is supported")
+		}
+		offset = req.Pagination.Offset
+		if req.Pagination.Limit > 0 {
+			limit = req.Pagination.Limit
+		}
+	}
+
+	entries := k.GetPeriodLeaderboard(ctx, startDay, endDay)
+	total := uint64(len(entries))
+
+	if offset > total {
+		offset = total
+	}
+	// compare against what is left rather than adding, since offset + limit may overflow
+	end := total
+	if limit < total-offset {
+		end = offset + limit
+	}
+
+	return &types.QueryPeriodLeaderboardResponse{
+		Entries:    entries[offset:end],
+		Pagination: &query.PageResponse{Total: total},
+		StartTime:  int64(startDay * types.PeriodBucketSeconds),
+		EndTime:    int64((endDay + 1) * types.PeriodBucketSeconds),
+	}, nil
+}
+
+// Categories implements the Query/Categories gRPC method
+func (k Keeper) Categories(goCtx context.Context, req *types.QueryCategoriesRequest) (*types.QueryCategoriesResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	categoryStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.CategoryPrefix)
+
+	var categories []types.Category
+	pageRes, err := query.Paginate(categoryStore, req.Pagination, func(_, value []byte) error {
+		var category types.Category
+		if err := k.cdc.Unmarshal(value, &category); err != nil {
+			return err
+		}
+		categories = append(categories, category)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCategoriesResponse{
+		Categories: categories,
+		Pagination: pageRes,
 	}, nil
 }
 
+// CategoryLeaderboard implements the Query/CategoryLeaderboard gRPC method
+func (k Keeper) CategoryLeaderboard(goCtx context.Context, req *types.QueryCategoryLeaderboardRequest) (*types.QueryCategoryLeaderboardResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidLeaderboard
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetCategory(ctx, req.CategoryId); !found {
+		return nil, errorsmod.Wrapf(types.ErrInvalidCategory, "category %d not found", req.CategoryId)
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = k.GetParams(ctx).DefaultLeaderboardLimit
+	}
+
+	entries, pageRes, err := k.paginateRankedIndex(ctx, types.CategoryLeaderboardPrefixKey(req.CategoryId), limit, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCategoryLeaderboardResponse{
+		Entries:    entries,
+		Pagination: pageRes,
+	}, nil
+}
+
+// CategoryBalances implements the Query/CategoryBalances gRPC method
+func (k Keeper) CategoryBalances(goCtx context.Context, req *types.QueryCategoryBalancesRequest) (*types.QueryCategoryBalancesResponse, error) {
+	if req == nil || req.Address == "" {
+		return nil, types.ErrInvalidAddress
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// The registry is small and governance-managed, so look the address up in every category
+	var balances []types.CategoryBalance
+	k.IterateCategories(ctx, func(category types.Category) bool {
+		if balance := k.GetCategoryBalance(ctx, category.Id, req.Address); balance > 0 {
+			balances = append(balances, types.CategoryBalance{
+				CategoryId: category.Id,
+				Address:    req.Address,
+				Balance:    balance,
+			})
+		}
+		return false
+	})
+
+	return &types.QueryCategoryBalancesResponse{Balances: balances}, nil
+}
+
+// paginateRankedIndex pages through a descending ranked index, falling back to limit
+// when the request carries no page size
+func (k Keeper) paginateRankedIndex(ctx sdk.Context, indexPrefix []byte, limit uint32, pagination *query.PageRequest) ([]types.LeaderboardEntry, *query.PageResponse, error) {
+	pageReq := &query.PageRequest{Limit: uint64(limit)}
+	if pagination != nil {
+		if pagination.Reverse {
+			return nil, nil, errorsmod.Wrap(types.ErrInvalidLeaderboard, "reverse pagination is not supported")
+		}
+
+		pageReq = pagination
+		if pageReq.Limit == 0 {
+			pageReq = &query.PageRequest{Key: pagination.Key, Offset: pagination.Offset, Limit: uint64(limit), CountTotal: pagination.CountTotal}
+		}
+	}
+
+	indexStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), indexPrefix)
+
+	// Ranks continue from the position the page starts at. A key page counts the entries before
+	// its key itself rather than trusting a rank supplied by the client.
+	rank := pageReq.Offset
+	if len(pageReq.Key) > 0 {
+		if len(pageReq.Key) <= 8 {
+			return nil, nil, errorsmod.Wrap(types.ErrInvalidLeaderboard, "invalid pagination key")
+		}
+
+		rank = countRankedBefore(indexStore, pageReq.Key)
+	}
+
+	var entries []types.LeaderboardEntry
+	pageRes, err := query.Paginate(indexStore, pageReq, func(key, _ []byte) error {
+		rank++
+		address, amount := types.ParseLeaderboardKey(key)
+		entries = append(entries, types.LeaderboardEntry{
+			Address: address,
+			Balance: amount,
+			Rank:    rank,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return entries, pageRes, nil
+}
+
+// countRankedBefore returns the number of ranked index entries that sort before key
+func countRankedBefore(indexStore prefix.Store, key []byte) uint64 {
+	iterator := indexStore.Iterator(nil, key)
+	defer iterator.Close()
+
+	var count uint64
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+	return count
+}
+
 // KudosDailyQuota implements the Query/KudosDailyQuota gRPC method
 func (k Keeper) KudosDailyQuota(goCtx context.Context, req *types.QueryDailyQuotaRequest) (*types.QueryDailyQuotaResponse, error) {
 	if req == nil {
@@ -59,3 +278,251 @@ func (k Keeper) KudosDailyQuota(goCtx context.Context, req *types.QueryDailyQuot
 
 	return &quota, nil
 }
+
+// Params implements the Query/Params gRPC method
+func (k Keeper) Params(goCtx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryParamsResponse{
+		Params: k.GetParams(ctx),
+	}, nil
+}
+
+// KudosHistory implements the Query/KudosHistory gRPC method
+func (k Keeper) KudosHistory(goCtx context.Context, req *types.QueryKudosHistoryRequest) (*types.QueryKudosHistoryResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// A category filter reads through the category index instead of the whole feed
+	if req.CategoryId != 0 {
+		history, pageRes, err := k.paginateHistoryIndex(ctx, types.HistoryByCategoryPrefixKey(req.CategoryId), 0, req.Pagination)
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.QueryKudosHistoryResponse{
+			History:    history,
+			Pagination: pageRes,
+		}, nil
+	}
+
+	historyStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.KudosHistoryPrefix)
+
+	var history []types.KudosHistory
+	pageRes, err := query.Paginate(historyStore, newestFirst(req.Pagination), func(key, value []byte) error {
+		var entry types.KudosHistory
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+		entry.Id = binary.BigEndian.Uint64(key)
+		history = append(history, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryKudosHistoryResponse{
+		History:    history,
+		Pagination: pageRes,
+	}, nil
+}
+
+// KudosSent implements the Query/KudosSent gRPC method
+func (k Keeper) KudosSent(goCtx context.Context, req *types.QueryKudosSentRequest) (*types.QueryKudosSentResponse, error) {
+	if req == nil || req.Address == "" {
+		return nil, types.ErrInvalidAddress
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	history, pageRes, err := k.paginateHistoryIndex(ctx, types.HistoryBySenderPrefixKey(req.Address), req.CategoryId, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryKudosSentResponse{
+		History:    history,
+		Pagination: pageRes,
+	}, nil
+}
+
+// KudosReceived implements the Query/KudosReceived gRPC method
+func (k Keeper) KudosReceived(goCtx context.Context, req *types.QueryKudosReceivedRequest) (*types.QueryKudosReceivedResponse, error) {
+	if req == nil || req.Address == "" {
+		return nil, types.ErrInvalidAddress
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	history, pageRes, err := k.paginateHistoryIndex(ctx, types.HistoryByRecipientPrefixKey(req.Address), req.CategoryId, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryKudosReceivedResponse{
+		History:    history,
+		Pagination: pageRes,
+	}, nil
+}
+
+// paginateHistoryIndex pages through a history index and resolves each ID to its history entry,
+// skipping entries outside categoryID unless it is 0
+func (k Keeper) paginateHistoryIndex(ctx sdk.Context, indexPrefix []byte, categoryID uint64, pageReq *query.PageRequest) ([]types.KudosHistory, *query.PageResponse, error) {
+	indexStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), indexPrefix)
+
+	var history []types.KudosHistory
+	pageRes, err := query.FilteredPaginate(indexStore, newestFirst(pageReq), func(key, _ []byte, accumulate bool) (bool, error) {
+		entry, found := k.GetKudosHistory(ctx, binary.BigEndian.Uint64(key))
+		if !found {
+			return false, errorsmod.Wrapf(types.ErrHistoryNotFound, "indexed history id %d", binary.BigEndian.Uint64(key))
+		}
+		if categoryID != 0 && entry.CategoryId != categoryID {
+			return false, nil
+		}
+		if accumulate {
+			history = append(history, entry)
+		}
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return history, pageRes, nil
+}
+
+// newestFirst flips the iteration order so history is returned newest first unless reverse is requested
+func newestFirst(pageReq *query.PageRequest) *query.PageRequest {
+	if pageReq == nil {
+		return &query.PageRequest{Reverse: true}
+	}
+
+	req := *pageReq
+	req.Reverse = !req.Reverse
+	return &req
+}
+
+// Season implements the Query/Season gRPC method
+func (k Keeper) Season(goCtx context.Context, req *types.QuerySeasonRequest) (*types.QuerySeasonResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	current, running := k.GetCurrentSeason(ctx)
+	if req.SeasonId == 0 {
+		if !running {
+			return nil, errorsmod.Wrap(types.ErrSeasonNotFound, "no season is running")
+		}
+		return &types.QuerySeasonResponse{Season: current}, nil
+	}
+
+	if running && current.Id == req.SeasonId {
+		return &types.QuerySeasonResponse{Season: current}, nil
+	}
+
+	season, found := k.GetSeason(ctx, req.SeasonId)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrSeasonNotFound, "season %d", req.SeasonId)
+	}
+
+	return &types.QuerySeasonResponse{Season: season}, nil
+}
+
+// SeasonStandings implements the Query/SeasonStandings gRPC method
+func (k Keeper) SeasonStandings(goCtx context.Context, req *types.QuerySeasonStandingsRequest) (*types.QuerySeasonStandingsResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if _, found := k.GetSeason(ctx, req.SeasonId); !found {
+		return nil, errorsmod.Wrapf(types.ErrSeasonNotFound, "season %d has not ended", req.SeasonId)
+	}
+
+	standingStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.SeasonStandingPrefixKey(req.SeasonId))
+
+	var standings []types.LeaderboardEntry
+	pageRes, err := query.Paginate(standingStore, req.Pagination, func(_, value []byte) error {
+		var entry types.LeaderboardEntry
+		if err := k.cdc.Unmarshal(value, &entry); err != nil {
+			return err
+		}
+		standings = append(standings, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySeasonStandingsResponse{
+		Standings:  standings,
+		Pagination: pageRes,
+	}, nil
+}
+
+// GiveableBalance implements the Query/GiveableBalance gRPC method
+func (k Keeper) GiveableBalance(goCtx context.Context, req *types.QueryGiveableBalanceRequest) (*types.QueryGiveableBalanceResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	giveable := k.GetGiveable(ctx, req.Address)
+
+	return &giveable, nil
+}
+
+// RewardPool implements the Query/RewardPool gRPC method
+func (k Keeper) RewardPool(goCtx context.Context, req *types.QueryRewardPoolRequest) (*types.QueryRewardPoolResponse, error) {
+	if req == nil {
+		return nil, types.ErrInvalidRequest
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	return &types.QueryRewardPoolResponse{
+		Address: k.RewardPoolAddress().String(),
+		Balance: k.GetRewardPool(ctx),
+	}, nil
+}
+
+// Redemptions implements the Query/Redemptions gRPC method
+func (k Keeper) Redemptions(goCtx context.Context, req *types.QueryRedemptionsRequest) (*types.QueryRedemptionsResponse, error) {
+	if req == nil || req.Address == "" {
+		return nil, types.ErrInvalidAddress
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	indexStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.RedemptionByAddressPrefixKey(req.Address))
+
+	var redemptions []types.Redemption
+	pageRes, err := query.Paginate(indexStore, newestFirst(req.Pagination), func(key, _ []byte) error {
+		redemption, found := k.GetRedemption(ctx, binary.BigEndian.Uint64(key))
+		if !found {
+			return errorsmod.Wrapf(types.ErrInvalidRequest, "indexed redemption id %d not found", binary.BigEndian.Uint64(key))
+		}
+		redemptions = append(redemptions, redemption)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRedemptionsResponse{
+		Redemptions: redemptions,
+		Pagination:  pageRes,
+	}, nil
+}
@@ -0,0 +1,406 @@
+package keeper_test
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+func historyIDs(history []types.KudosHistory) []uint64 {
+	ids := make([]uint64, 0, len(history))
+	for _, entry := range history {
+		ids = append(ids, entry.Id)
+	}
+	return ids
+}
+
+func TestQueryKudosHistory(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1bob", 1, "one", 0, nil))
+	require.NoError(t, k.SendKudos(ctx, "cosmos1bob", "cosmos1carol", 2, "two", 0, nil))
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1carol", 3, "three", 0, nil))
+
+	res, err := k.KudosHistory(ctx, &types.QueryKudosHistoryRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3, 2, 1}, historyIDs(res.History))
+	require.Equal(t, "three", res.History[0].Comment)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+
+	// First page of two, then follow next_key
+	res, err = k.KudosHistory(ctx, &types.QueryKudosHistoryRequest{Pagination: &query.PageRequest{Limit: 2}})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3, 2}, historyIDs(res.History))
+	require.NotNil(t, res.Pagination.NextKey)
+
+	res, err = k.KudosHistory(ctx, &types.QueryKudosHistoryRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 2}})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1}, historyIDs(res.History))
+	require.Nil(t, res.Pagination.NextKey)
+
+	// Reverse lists the oldest first
+	res, err = k.KudosHistory(ctx, &types.QueryKudosHistoryRequest{Pagination: &query.PageRequest{Reverse: true}})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2, 3}, historyIDs(res.History))
+}
+
+func TestQueryKudosSentAndReceived(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1bob", 1, "", 0, nil))
+	require.NoError(t, k.SendKudos(ctx, "cosmos1bob", "cosmos1alice", 2, "", 0, nil))
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1carol", 3, "", 0, nil))
+	// An address that is a string prefix of another must not leak into its index
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alicex", "cosmos1bob", 4, "", 0, nil))
+
+	sent, err := k.KudosSent(ctx, &types.QueryKudosSentRequest{Address: "cosmos1alice"})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{3, 1}, historyIDs(sent.History))
+	require.Equal(t, uint64(2), sent.Pagination.Total)
+
+	received, err := k.KudosReceived(ctx, &types.QueryKudosReceivedRequest{Address: "cosmos1bob"})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{4, 1}, historyIDs(received.History))
+
+	received, err = k.KudosReceived(ctx, &types.QueryKudosReceivedRequest{
+		Address:    "cosmos1bob",
+		Pagination: &query.PageRequest{Offset: 1, Limit: 1},
+	})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1}, historyIDs(received.History))
+
+	received, err = k.KudosReceived(ctx, &types.QueryKudosReceivedRequest{Address: "cosmos1nobody"})
+	require.NoError(t, err)
+	require.Empty(t, received.History)
+
+	_, err = k.KudosSent(ctx, &types.QueryKudosSentRequest{})
+	require.ErrorIs(t, err, types.ErrInvalidAddress)
+}
+
+func TestHistoryIndexesRebuiltFromGenesis(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1bob", 1, "", 0, nil))
+	require.NoError(t, k.SendKudos(ctx, "cosmos1carol", "cosmos1bob", 2, "", 0, nil))
+
+	k2, ctx2 := setupKeeper(t)
+	k2.InitGenesis(ctx2, *k.ExportGenesis(ctx))
+
+	received, err := k2.KudosReceived(ctx2, &types.QueryKudosReceivedRequest{Address: "cosmos1bob"})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2, 1}, historyIDs(received.History))
+}
+
+func TestQueryKudosLeaderboard(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	k.SetKudosBalance(ctx, "cosmos1dave", 50)
+	k.SetKudosBalance(ctx, "cosmos1carol", 100)
+	k.SetKudosBalance(ctx, "cosmos1bob", 100)
+	k.SetKudosBalance(ctx, "cosmos1alice", 200)
+
+	res, err := k.KudosLeaderboard(ctx, &types.QueryKudosLeaderboardRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.LeaderboardEntry{
+		{Address: "cosmos1alice", Balance: 200, Rank: 1},
+		{Address: "cosmos1bob", Balance: 100, Rank: 2},
+		{Address: "cosmos1carol", Balance: 100, Rank: 3},
+		{Address: "cosmos1dave", Balance: 50, Rank: 4},
+	}, res.Entries)
+
+	// Offset pagination keeps absolute ranks
+	res, err = k.KudosLeaderboard(ctx, &types.QueryKudosLeaderboardRequest{Pagination: &query.PageRequest{Offset: 1, Limit: 2}})
+	require.NoError(t, err)
+	require.Equal(t, []types.LeaderboardEntry{
+		{Address: "cosmos1bob", Balance: 100, Rank: 2},
+		{Address: "cosmos1carol", Balance: 100, Rank: 3},
+	}, res.Entries)
+
+	// Key pagination resumes with the next rank
+	res, err = k.KudosLeaderboard(ctx, &types.QueryKudosLeaderboardRequest{Limit: 3})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 3)
+	require.NotNil(t, res.Pagination.NextKey)
+
+	res, err = k.KudosLeaderboard(ctx, &types.QueryKudosLeaderboardRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey, Limit: 3}})
+	require.NoError(t, err)
+	require.Equal(t, []types.LeaderboardEntry{{Address: "cosmos1dave", Balance: 50, Rank: 4}}, res.Entries)
+	require.Nil(t, res.Pagination.NextKey)
+
+	// Every key page picks up the rank where the previous one stopped
+	var ranks []uint64
+	pageReq := &query.PageRequest{Limit: 1}
+	for {
+		res, err = k.KudosLeaderboard(ctx, &types.QueryKudosLeaderboardRequest{Pagination: pageReq})
+		require.NoError(t, err)
+		require.Len(t, res.Entries, 1)
+		ranks = append(ranks, res.Entries[0].Rank)
+		if res.Pagination.NextKey == nil {
+			break
+		}
+		pageReq = &query.PageRequest{Key: res.Pagination.NextKey, Limit: 1}
+	}
+	require.Equal(t, []uint64{1, 2, 3, 4}, ranks)
+
+	// Ranks are counted on the server, so a key built by the client cannot forge them
+	forged := append(types.LeaderboardKey("cosmos1carol", 100)[len(types.LeaderboardPrefix):], sdk.Uint64ToBigEndian(99)...)
+	res, err = k.KudosLeaderboard(ctx, &types.QueryKudosLeaderboardRequest{Pagination: &query.PageRequest{Key: forged}})
+	require.NoError(t, err)
+	require.Equal(t, []types.LeaderboardEntry{{Address: "cosmos1dave", Balance: 50, Rank: 4}}, res.Entries)
+
+	_, err = k.KudosLeaderboard(ctx, &types.QueryKudosLeaderboardRequest{Pagination: &query.PageRequest{Key: []byte{1, 2, 3}}})
+	require.ErrorIs(t, err, types.ErrInvalidLeaderboard)
+
+	_, err = k.KudosLeaderboard(ctx, &types.QueryKudosLeaderboardRequest{Pagination: &query.PageRequest{Reverse: true}})
+	require.ErrorIs(t, err, types.ErrInvalidLeaderboard)
+}
+
+func TestQueryGiverLeaderboard(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	k.SetKudosSentTotal(ctx, "cosmos1carol", 30)
+	k.SetKudosSentTotal(ctx, "cosmos1bob", 30)
+	k.SetKudosSentTotal(ctx, "cosmos1alice", 80)
+	k.SetKudosSentTotal(ctx, "cosmos1alice", 90)
+
+	res, err := k.GiverLeaderboard(ctx, &types.QueryGiverLeaderboardRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.LeaderboardEntry{
+		{Address: "cosmos1alice", Balance: 90, Rank: 1},
+		{Address: "cosmos1bob", Balance: 30, Rank: 2},
+		{Address: "cosmos1carol", Balance: 30, Rank: 3},
+	}, res.Entries)
+
+	res, err = k.GiverLeaderboard(ctx, &types.QueryGiverLeaderboardRequest{Pagination: &query.PageRequest{Offset: 2, Limit: 1}})
+	require.NoError(t, err)
+	require.Equal(t, []types.LeaderboardEntry{{Address: "cosmos1carol", Balance: 30, Rank: 3}}, res.Entries)
+
+	_, err = k.GiverLeaderboard(ctx, nil)
+	require.ErrorIs(t, err, types.ErrInvalidLeaderboard)
+}
+
+func TestQueryPeriodLeaderboard(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	start := time.Unix(1_700_000_000, 0)
+	ctx = ctx.WithBlockTime(start)
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1bob", 50, "", 0, nil))
+
+	// Ten days later carol overtakes bob within the last week
+	ctx = ctx.WithBlockTime(start.Add(10 * 24 * time.Hour))
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1carol", 20, "", 0, nil))
+	require.NoError(t, k.SendKudos(ctx, "cosmos1dave", "cosmos1bob", 5, "", 0, nil))
+
+	res, err := k.PeriodLeaderboard(ctx, &types.QueryPeriodLeaderboardRequest{Period: types.PeriodWeek})
+	require.NoError(t, err)
+	require.Equal(t, []types.LeaderboardEntry{
+		{Address: "cosmos1carol", Balance: 20, Rank: 1},
+		{Address: "cosmos1bob", Balance: 5, Rank: 2},
+	}, res.Entries)
+	require.Equal(t, uint64(2), res.Pagination.Total)
+
+	res, err = k.PeriodLeaderboard(ctx, &types.QueryPeriodLeaderboardRequest{Period: types.PeriodMonth})
+	require.NoError(t, err)
+	require.Equal(t, []types.LeaderboardEntry{
+		{Address: "cosmos1bob", Balance: 55, Rank: 1},
+		{Address: "cosmos1carol", Balance: 20, Rank: 2},
+	}, res.Entries)
+
+	// An explicit range covering only the first day
+	res, err = k.PeriodLeaderboard(ctx, &types.QueryPeriodLeaderboardRequest{StartTime: start.Unix(), EndTime: start.Unix() + 1})
+	require.NoError(t, err)
+	require.Equal(t, []types.LeaderboardEntry{{Address: "cosmos1bob", Balance: 50, Rank: 1}}, res.Entries)
+	require.LessOrEqual(t, res.StartTime, start.Unix())
+	require.Greater(t, res.EndTime, start.Unix())
+
+	// Offset pagination keeps absolute ranks
+	res, err = k.PeriodLeaderboard(ctx, &types.QueryPeriodLeaderboardRequest{Period: types.PeriodMonth, Pagination: &query.PageRequest{Offset: 1, Limit: 1}})
+	require.NoError(t, err)
+	require.Equal(t, []types.LeaderboardEntry{{Address: "cosmos1carol", Balance: 20, Rank: 2}}, res.Entries)
+
+	// A page size that would overflow offset + limit still returns the rest of the board
+	res, err = k.PeriodLeaderboard(ctx, &types.QueryPeriodLeaderboardRequest{Period: types.PeriodMonth, Pagination: &query.PageRequest{Offset: 1, Limit: math.MaxUint64}})
+	require.NoError(t, err)
+	require.Equal(t, []types.LeaderboardEntry{{Address: "cosmos1carol", Balance: 20, Rank: 2}}, res.Entries)
+
+	_, err = k.PeriodLeaderboard(ctx, &types.QueryPeriodLeaderboardRequest{Pagination: &query.PageRequest{Key: []byte{1}}})
+	require.ErrorIs(t, err, types.ErrInvalidLeaderboard)
+}
+
+func TestQueryCategories(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	k.SetCategory(ctx, types.NewCategory(2, "on-call", "", true))
+	k.SetCategory(ctx, types.NewCategory(1, "code-review", "", true))
+
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1bob", 10, "review", 1, nil))
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1bob", 4, "pager", 2, nil))
+	require.NoError(t, k.SendKudos(ctx, "cosmos1carol", "cosmos1bob", 6, "", 0, nil))
+	require.NoError(t, k.SendKudos(ctx, "cosmos1carol", "cosmos1dave", 8, "review", 1, nil))
+
+	categories, err := k.Categories(ctx, &types.QueryCategoriesRequest{})
+	require.NoError(t, err)
+	require.Len(t, categories.Categories, 2)
+	require.Equal(t, uint64(1), categories.Categories[0].Id)
+
+	leaderboard, err := k.CategoryLeaderboard(ctx, &types.QueryCategoryLeaderboardRequest{CategoryId: 1})
+	require.NoError(t, err)
+	require.Equal(t, []types.LeaderboardEntry{
+		{Address: "cosmos1bob", Balance: 10, Rank: 1},
+		{Address: "cosmos1dave", Balance: 8, Rank: 2},
+	}, leaderboard.Entries)
+
+	_, err = k.CategoryLeaderboard(ctx, &types.QueryCategoryLeaderboardRequest{CategoryId: 5})
+	require.ErrorIs(t, err, types.ErrInvalidCategory)
+
+	balances, err := k.CategoryBalances(ctx, &types.QueryCategoryBalancesRequest{Address: "cosmos1bob"})
+	require.NoError(t, err)
+	require.Equal(t, []types.CategoryBalance{
+		{CategoryId: 1, Address: "cosmos1bob", Balance: 10},
+		{CategoryId: 2, Address: "cosmos1bob", Balance: 4},
+	}, balances.Balances)
+
+	// History filters
+	history, err := k.KudosHistory(ctx, &types.QueryKudosHistoryRequest{CategoryId: 1})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{4, 1}, historyIDs(history.History))
+
+	received, err := k.KudosReceived(ctx, &types.QueryKudosReceivedRequest{Address: "cosmos1bob", CategoryId: 2})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2}, historyIDs(received.History))
+
+	sent, err := k.KudosSent(ctx, &types.QueryKudosSentRequest{Address: "cosmos1alice", CategoryId: 1, Pagination: &query.PageRequest{CountTotal: true}})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1}, historyIDs(sent.History))
+	require.Equal(t, uint64(1), sent.Pagination.Total)
+}
+
+func TestQuerySeasons(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	_, err := k.Season(ctx, &types.QuerySeasonRequest{})
+	require.ErrorIs(t, err, types.ErrSeasonNotFound)
+
+	params := types.DefaultParams()
+	params.SeasonLengthSeconds = 3600
+	k.SetParams(ctx, params)
+	k.ApplySeasonRollover(ctx)
+
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1bob", 10, "", 0, nil))
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1carol", 20, "", 0, nil))
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1dave", 5, "", 0, nil))
+
+	current, err := k.Season(ctx, &types.QuerySeasonRequest{})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), current.Season.Id)
+
+	// Standings only exist once the season has ended
+	_, err = k.SeasonStandings(ctx, &types.QuerySeasonStandingsRequest{SeasonId: 1})
+	require.ErrorIs(t, err, types.ErrSeasonNotFound)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	k.ApplySeasonRollover(ctx)
+
+	ended, err := k.Season(ctx, &types.QuerySeasonRequest{SeasonId: 1})
+	require.NoError(t, err)
+	require.Equal(t, current.Season, ended.Season)
+
+	current, err = k.Season(ctx, &types.QuerySeasonRequest{SeasonId: 2})
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), current.Season.Id)
+
+	_, err = k.Season(ctx, &types.QuerySeasonRequest{SeasonId: 3})
+	require.ErrorIs(t, err, types.ErrSeasonNotFound)
+
+	page, err := k.SeasonStandings(ctx, &types.QuerySeasonStandingsRequest{SeasonId: 1, Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	require.NoError(t, err)
+	require.Equal(t, []types.LeaderboardEntry{
+		{Address: "cosmos1carol", Balance: 20, Rank: 1},
+		{Address: "cosmos1bob", Balance: 10, Rank: 2},
+	}, page.Standings)
+	require.Equal(t, uint64(3), page.Pagination.Total)
+
+	page, err = k.SeasonStandings(ctx, &types.QuerySeasonStandingsRequest{SeasonId: 1, Pagination: &query.PageRequest{Key: page.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Equal(t, []types.LeaderboardEntry{{Address: "cosmos1dave", Balance: 5, Rank: 3}}, page.Standings)
+}
+
+func TestQueryGiveableBalance(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	_, err := k.GiveableBalance(ctx, nil)
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+
+	params := types.DefaultParams()
+	params.BudgetEnabled = true
+	params.BudgetPerEpoch = 30
+	params.BudgetEpochSeconds = 3600
+	k.SetParams(ctx, params)
+	ctx = ctx.WithBlockTime(time.Unix(10*3600+60, 0))
+
+	require.NoError(t, k.SendKudos(ctx, "cosmos1alice", "cosmos1bob", 12, "", 0, nil))
+
+	res, err := k.GiveableBalance(ctx, &types.QueryGiveableBalanceRequest{Address: "cosmos1alice"})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryGiveableBalanceResponse{BudgetEnabled: true, Remaining: 18, Allowance: 30, ResetAt: 11 * 3600}, res)
+
+	// The query does not refill or store anything
+	_, found := k.GetGiveableBalance(ctx, "cosmos1bob")
+	res, err = k.GiveableBalance(ctx, &types.QueryGiveableBalanceRequest{Address: "cosmos1bob"})
+	require.NoError(t, err)
+	require.Equal(t, uint64(30), res.Remaining)
+	require.False(t, found)
+}
+
+func TestQueryRewardPoolAndRedemptions(t *testing.T) {
+	k, ctx, bank := setupKeeperWithBank(t)
+
+	_, err := k.RewardPool(ctx, nil)
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+	_, err = k.Redemptions(ctx, &types.QueryRedemptionsRequest{})
+	require.ErrorIs(t, err, types.ErrInvalidAddress)
+
+	alice := sdk.AccAddress([]byte("alice_______________")).String()
+	bob := sdk.AccAddress([]byte("bob_________________")).String()
+	bank.balances[alice] = sdk.NewCoins(sdk.NewInt64Coin("stake", 100))
+	k.SetKudosBalance(ctx, bob, 10)
+
+	params := types.DefaultParams()
+	params.RedeemDenom = "stake"
+	params.RedeemRate = 2
+	k.SetParams(ctx, params)
+
+	require.NoError(t, k.FundRewardPool(ctx, alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 100))))
+	for _, amount := range []uint64{1, 2, 3} {
+		_, err := k.RedeemKudos(ctx, bob, amount)
+		require.NoError(t, err)
+	}
+
+	pool, err := k.RewardPool(ctx, &types.QueryRewardPoolRequest{})
+	require.NoError(t, err)
+	require.Equal(t, k.RewardPoolAddress().String(), pool.Address)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 88)), pool.Balance)
+
+	// Newest first, paginated
+	res, err := k.Redemptions(ctx, &types.QueryRedemptionsRequest{Address: bob, Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, res.Redemptions, 2)
+	require.Equal(t, uint64(3), res.Redemptions[0].Id)
+	require.Equal(t, uint64(2), res.Redemptions[1].Id)
+	require.Equal(t, uint64(3), res.Pagination.Total)
+
+	res, err = k.Redemptions(ctx, &types.QueryRedemptionsRequest{Address: bob, Pagination: &query.PageRequest{Reverse: true}})
+	require.NoError(t, err)
+	require.Len(t, res.Redemptions, 3)
+	require.Equal(t, uint64(1), res.Redemptions[0].Amount)
+
+	res, err = k.Redemptions(ctx, &types.QueryRedemptionsRequest{Address: alice})
+	require.NoError(t, err)
+	require.Empty(t, res.Redemptions)
+}
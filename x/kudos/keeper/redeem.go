@@ -0,0 +1,295 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+// RewardPoolAddress returns the address of the kudos module account that holds the reward pool
+func (k Keeper) RewardPoolAddress() sdk.AccAddress {
+	return authtypes.NewModuleAddress(types.ModuleName)
+}
+
+// GetRewardPool returns the coins held by the reward pool, which is always empty when the keeper
+// has no bank keeper
+func (k Keeper) GetRewardPool(ctx sdk.Context) sdk.Coins {
+	if k.bankKeeper == nil {
+		return sdk.Coins{}
+	}
+
+	return k.bankKeeper.GetAllBalances(ctx, k.RewardPoolAddress())
+}
+
+// FundRewardPool moves coins from the depositor into the reward pool. Only params.redeem_denom
+// is accepted so that every deposit can be paid out again.
+func (k Keeper) FundRewardPool(ctx sdk.Context, depositor string, amount sdk.Coins) error {
+	denom := k.GetParams(ctx).RedeemDenom
+	if denom == "" {
+		return errorsmod.Wrap(types.ErrRedeemDisabled, "the reward pool cannot be funded while redemption is disabled")
+	}
+	if k.bankKeeper == nil {
+		return errorsmod.Wrap(types.ErrRedeemDisabled, "the reward pool is not supported on this chain")
+	}
+
+	if !amount.IsValid() || amount.Empty() {
+		return errorsmod.Wrapf(types.ErrInvalidAmount, "invalid funding amount %s", amount)
+	}
+	for _, coin := range amount {
+		if coin.Denom != denom {
+			return errorsmod.Wrapf(types.ErrInvalidRequest, "the reward pool only accepts %s, got %s", denom, coin.Denom)
+		}
+	}
+
+	depositorAddr, err := sdk.AccAddressFromBech32(depositor)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidAddress, "invalid depositor address: %s", err)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositorAddr, types.ModuleName, amount); err != nil {
+		return err
+	}
+
+	// Legacy event, deprecated in favour of EventRewardPoolFunded
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.ModuleName,
+			sdk.NewAttribute("action", "fund_reward_pool"),
+			sdk.NewAttribute("depositor", depositor),
+			sdk.NewAttribute("amount", amount.String()),
+		),
+	)
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventRewardPoolFunded{Depositor: depositor, Amount: amount})
+}
+
+// RedeemKudos burns amount kudos from the balance of address and pays out amount * params.redeem_rate
+// of params.redeem_denom from the reward pool. Lifetime totals, category balances and history are
+// kept as they are; only the spendable balance shrinks.
+func (k Keeper) RedeemKudos(ctx sdk.Context, address string, amount uint64) (types.Redemption, error) {
+	params := k.GetParams(ctx)
+	if params.RedeemDenom == "" {
+		return types.Redemption{}, types.ErrRedeemDisabled
+	}
+	if k.bankKeeper == nil {
+		return types.Redemption{}, errorsmod.Wrap(types.ErrRedeemDisabled, "the reward pool is not supported on this chain")
+	}
+
+	if amount == 0 {
+		return types.Redemption{}, types.ErrInvalidAmount
+	}
+
+	recipient, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return types.Redemption{}, errorsmod.Wrapf(types.ErrInvalidAddress, "invalid address: %s", err)
+	}
+
+	// Balances are frozen while an ended season is archived
+	if err := k.checkSeasonRollover(ctx); err != nil {
+		return types.Redemption{}, err
+	}
+
+	balance := k.GetKudosBalance(ctx, address)
+	if amount > balance {
+		return types.Redemption{}, errorsmod.Wrapf(types.ErrInsufficientKudos, "address %s has %d kudos, needs %d", address, balance, amount)
+	}
+
+	redeemed, epoch := k.currentRedeemed(ctx, params, address)
+	if left := subFloor(params.RedeemCapPerEpoch, redeemed); params.RedeemCapPerEpoch > 0 && amount > left {
+		return types.Redemption{}, errorsmod.Wrapf(types.ErrRedeemCapExceeded, "address %s may redeem %d more kudos this epoch, requested %d", address, left, amount)
+	}
+
+	payout := sdk.NewCoin(params.RedeemDenom, math.NewIntFromUint64(amount).Mul(math.NewIntFromUint64(params.RedeemRate)))
+	if pool := k.bankKeeper.GetBalance(ctx, k.RewardPoolAddress(), params.RedeemDenom); pool.IsLT(payout) {
+		return types.Redemption{}, errorsmod.Wrapf(types.ErrRewardPoolDepleted, "pool holds %s, redemption needs %s", pool, payout)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, sdk.NewCoins(payout)); err != nil {
+		return types.Redemption{}, err
+	}
+
+	k.SetKudosBalance(ctx, address, balance-amount)
+	if params.RedeemCapPerEpoch > 0 {
+		k.SetRedeemUsage(ctx, types.RedeemUsage{Address: address, Redeemed: redeemed + amount, Epoch: epoch})
+	}
+
+	id := k.GetRedemptionCounter(ctx) + 1
+	redemption := types.Redemption{
+		Id:          id,
+		Address:     address,
+		Amount:      amount,
+		Payout:      payout,
+		Timestamp:   ctx.BlockTime().Unix(),
+		BlockHeight: ctx.BlockHeight(),
+	}
+	k.SetRedemption(ctx, redemption)
+	k.SetRedemptionCounter(ctx, id)
+
+	// Legacy event, deprecated in favour of EventKudosRedeemed
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.ModuleName,
+			sdk.NewAttribute("action", "redeem_kudos"),
+			sdk.NewAttribute("address", address),
+			sdk.NewAttribute("amount", fmt.Sprintf("%d", amount)),
+			sdk.NewAttribute("payout", payout.String()),
+			sdk.NewAttribute("redemption_id", fmt.Sprintf("%d", id)),
+		),
+	)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventKudosRedeemed{
+		RedemptionId: id,
+		Address:      address,
+		Amount:       amount,
+		Payout:       payout,
+	}); err != nil {
+		return types.Redemption{}, err
+	}
+
+	return redemption, nil
+}
+
+// currentRedeemed returns how many kudos address has redeemed in the running redeem epoch and
+// the index of that epoch; usage from an earlier epoch counts as 0
+func (k Keeper) currentRedeemed(ctx sdk.Context, params types.Params, address string) (uint64, uint64) {
+	if params.RedeemEpochSeconds == 0 {
+		return 0, 0
+	}
+
+	epoch := uint64(ctx.BlockTime().Unix()) / params.RedeemEpochSeconds
+	usage, found := k.GetRedeemUsage(ctx, address)
+	if !found || usage.Epoch != epoch {
+		return 0, epoch
+	}
+
+	return usage.Redeemed, epoch
+}
+
+// GetRedeemUsage returns the kudos an address redeemed as of the redeem epoch it last redeemed in
+func (k Keeper) GetRedeemUsage(ctx sdk.Context, address string) (types.RedeemUsage, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	bz, err := store.Get(types.RedeemUsageKey(address))
+	if err != nil || bz == nil {
+		return types.RedeemUsage{}, false
+	}
+
+	return types.RedeemUsage{
+		Address:  address,
+		Redeemed: binary.BigEndian.Uint64(bz[:8]),
+		Epoch:    binary.BigEndian.Uint64(bz[8:]),
+	}, true
+}
+
+// SetRedeemUsage stores the kudos an address redeemed within a redeem epoch
+func (k Keeper) SetRedeemUsage(ctx sdk.Context, usage types.RedeemUsage) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	bz := make([]byte, 16)
+	binary.BigEndian.PutUint64(bz[:8], usage.Redeemed)
+	binary.BigEndian.PutUint64(bz[8:], usage.Epoch)
+
+	if err := store.Set(types.RedeemUsageKey(usage.Address), bz); err != nil {
+		panic(err)
+	}
+}
+
+// IterateRedeemUsages iterates over all stored redeem usages in address order until cb returns true
+func (k Keeper) IterateRedeemUsages(ctx sdk.Context, cb func(usage types.RedeemUsage) (stop bool)) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	iterator, err := store.Iterator(types.RedeemUsagePrefix, storetypes.PrefixEndBytes(types.RedeemUsagePrefix))
+	if err != nil {
+		panic(err)
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		bz := iterator.Value()
+		usage := types.RedeemUsage{
+			Address:  string(iterator.Key()[len(types.RedeemUsagePrefix):]),
+			Redeemed: binary.BigEndian.Uint64(bz[:8]),
+			Epoch:    binary.BigEndian.Uint64(bz[8:]),
+		}
+		if cb(usage) {
+			break
+		}
+	}
+}
+
+// GetRedemptionCounter returns the ID of the most recent redemption
+func (k Keeper) GetRedemptionCounter(ctx sdk.Context) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+
+	bz, err := store.Get(types.RedemptionCounterKey)
+	if err != nil || bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+// SetRedemptionCounter sets the redemption counter
+func (k Keeper) SetRedemptionCounter(ctx sdk.Context, counter uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	bz := make([]byte, 8)
+	binary.BigEndian.PutUint64(bz, counter)
+
+	if err := store.Set(types.RedemptionCounterKey, bz); err != nil {
+		panic(err)
+	}
+}
+
+// SetRedemption stores a redemption under its ID along with its address index
+func (k Keeper) SetRedemption(ctx sdk.Context, redemption types.Redemption) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	if err := store.Set(types.RedemptionKey(redemption.Id), k.cdc.MustMarshal(&redemption)); err != nil {
+		panic(err)
+	}
+
+	if err := store.Set(types.RedemptionByAddressKey(redemption.Address, redemption.Id), []byte{}); err != nil {
+		panic(err)
+	}
+}
+
+// GetRedemption returns the redemption with the given ID
+func (k Keeper) GetRedemption(ctx sdk.Context, id uint64) (types.Redemption, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	bz, err := store.Get(types.RedemptionKey(id))
+	if err != nil || bz == nil {
+		return types.Redemption{}, false
+	}
+
+	var redemption types.Redemption
+	k.cdc.MustUnmarshal(bz, &redemption)
+
+	return redemption, true
+}
+
+// IterateRedemptions iterates over all redemptions in ID order until cb returns true
+func (k Keeper) IterateRedemptions(ctx sdk.Context, cb func(redemption types.Redemption) (stop bool)) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	iterator, err := store.Iterator(types.RedemptionPrefix, storetypes.PrefixEndBytes(types.RedemptionPrefix))
+	if err != nil {
+		panic(err)
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var redemption types.Redemption
+		k.cdc.MustUnmarshal(iterator.Value(), &redemption)
+		if cb(redemption) {
+			break
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+// RevokeKudos reverses the transfer recorded by a history entry. Only the original sender
+// may revoke it, once, within the revoke grace period, and only if it carried no tip. The
+// recipient's balances and buckets and the sender's sent total are decremented, and the
+// amount is returned to the sender's quota and giveable allowance when the transfer was
+// charged to the current window and budget epoch. The revocation fails if the recipient no
+// longer holds the amount, e.g. because it was redeemed, so revoking never mints kudos, and
+// for transfers made before the last season ended, whose balance has since been reset.
+func (k Keeper) RevokeKudos(ctx sdk.Context, fromAddress string, historyID uint64) error {
+	history, found := k.GetKudosHistory(ctx, historyID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrHistoryNotFound, "id %d", historyID)
+	}
+
+	if history.FromAddress != fromAddress {
+		return errorsmod.Wrapf(types.ErrNotSender, "history entry %d was sent by %s", historyID, history.FromAddress)
+	}
+
+	if history.Revoked {
+		return errorsmod.Wrapf(types.ErrAlreadyRevoked, "history entry %d", historyID)
+	}
+
+	// Tips are paid out through x/bank and cannot be taken back from the recipient
+	if !history.Tip.Empty() {
+		return errorsmod.Wrapf(types.ErrInvalidTip, "history entry %d carried a tip of %s and cannot be revoked", historyID, history.Tip)
+	}
+
+	params := k.GetParams(ctx)
+	if params.RevokeGracePeriodSeconds == 0 {
+		return errorsmod.Wrap(types.ErrRevokeExpired, "revocation is disabled")
+	}
+	now := ctx.BlockTime().Unix()
+	if deadline := history.Timestamp + int64(params.RevokeGracePeriodSeconds); history.Legacy || now >= deadline {
+		return errorsmod.Wrapf(types.ErrRevokeExpired, "history entry %d could be revoked until %d", historyID, deadline)
+	}
+
+	// Balances are frozen while an ended season is archived
+	if err := k.checkSeasonRollover(ctx); err != nil {
+		return err
+	}
+
+	// A season reset zeroed the balance the transfer went into, so taking it back now would
+	// take it from the kudos received in a later season
+	if season, found := k.GetSeason(ctx, k.lastSeasonID(ctx)); found && history.Timestamp < season.EndTime {
+		return errorsmod.Wrapf(types.ErrRevokeExpired, "history entry %d was sent in season %d, which has ended", historyID, season.Id)
+	}
+
+	// Kudos the recipient has redeemed or lost to decay cannot be taken back
+	balance := k.GetKudosBalance(ctx, history.ToAddress)
+	if balance < history.Amount {
+		return errorsmod.Wrapf(types.ErrInsufficientKudos, "%s holds %d kudos but history entry %d sent %d", history.ToAddress, balance, historyID, history.Amount)
+	}
+
+	// Reverse the recipient side
+	k.SetKudosBalance(ctx, history.ToAddress, balance-history.Amount)
+	k.SetKudosLifetimeTotal(ctx, history.ToAddress, subFloor(k.GetKudosLifetimeTotal(ctx, history.ToAddress), history.Amount))
+
+	if history.CategoryId != 0 {
+		balance := k.GetCategoryBalance(ctx, history.CategoryId, history.ToAddress)
+		k.SetCategoryBalance(ctx, history.CategoryId, history.ToAddress, subFloor(balance, history.Amount))
+	}
+
+	day := types.PeriodDay(time.Unix(history.Timestamp, 0))
+	k.SetPeriodAmount(ctx, day, history.ToAddress, subFloor(k.GetPeriodAmount(ctx, day, history.ToAddress), history.Amount))
+
+	// Reverse the sender side
+	k.SetKudosSentTotal(ctx, fromAddress, subFloor(k.GetKudosSentTotal(ctx, fromAddress), history.Amount))
+
+	// Refund the quota only if the transfer was charged to the window that is still open
+	used, resetAt := k.getDailyUsage(ctx, fromAddress)
+	windowStart := resetAt - int64(params.QuotaWindowSeconds)
+	if now < resetAt && history.Timestamp >= windowStart {
+		k.setDailyUsage(ctx, fromAddress, subFloor(used, history.Amount), resetAt)
+	}
+
+	// Likewise the giveable allowance, only if the transfer drew on the epoch that is still running
+	k.refundGiveable(ctx, fromAddress, history.Amount, history.Timestamp)
+
+	history.Revoked = true
+	history.RevokedAt = now
+	k.SetKudosHistory(ctx, history)
+
+	// Legacy event, deprecated in favour of EventKudosRevoked
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.ModuleName,
+			sdk.NewAttribute("action", "revoke_kudos"),
+			sdk.NewAttribute("from", history.FromAddress),
+			sdk.NewAttribute("to", history.ToAddress),
+			sdk.NewAttribute("amount", fmt.Sprintf("%d", history.Amount)),
+			sdk.NewAttribute("history_id", fmt.Sprintf("%d", historyID)),
+		),
+	)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventKudosRevoked{
+		HistoryId:   historyID,
+		FromAddress: history.FromAddress,
+		ToAddress:   history.ToAddress,
+		Amount:      history.Amount,
+	}); err != nil {
+		return err
+	}
+
+	return k.Hooks().AfterKudosRevoked(ctx, history)
+}
+
+// subFloor returns a - b, or 0 when b exceeds a
+func subFloor(a, b uint64) uint64 {
+	if b > a {
+		return 0
+	}
+	return a - b
+}
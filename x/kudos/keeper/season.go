@@ -0,0 +1,276 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+// GetCurrentSeason returns the running season
+func (k Keeper) GetCurrentSeason(ctx sdk.Context) (types.Season, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	bz, err := store.Get(types.CurrentSeasonKey)
+	if err != nil || bz == nil {
+		return types.Season{}, false
+	}
+
+	var season types.Season
+	k.cdc.MustUnmarshal(bz, &season)
+
+	return season, true
+}
+
+// SetCurrentSeason sets the running season
+func (k Keeper) SetCurrentSeason(ctx sdk.Context, season types.Season) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&season)
+
+	if err := store.Set(types.CurrentSeasonKey, bz); err != nil {
+		panic(err)
+	}
+}
+
+// GetSeason returns an ended season
+func (k Keeper) GetSeason(ctx sdk.Context, id uint64) (types.Season, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	bz, err := store.Get(types.SeasonKey(id))
+	if err != nil || bz == nil {
+		return types.Season{}, false
+	}
+
+	var season types.Season
+	k.cdc.MustUnmarshal(bz, &season)
+
+	return season, true
+}
+
+// SetSeason archives an ended season
+func (k Keeper) SetSeason(ctx sdk.Context, season types.Season) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&season)
+
+	if err := store.Set(types.SeasonKey(season.Id), bz); err != nil {
+		panic(err)
+	}
+}
+
+// IterateSeasons iterates over all ended seasons in ID order until cb returns true
+func (k Keeper) IterateSeasons(ctx sdk.Context, cb func(season types.Season) (stop bool)) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	iterator, err := store.Iterator(types.SeasonPrefix, storetypes.PrefixEndBytes(types.SeasonPrefix))
+	if err != nil {
+		panic(err)
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var season types.Season
+		k.cdc.MustUnmarshal(iterator.Value(), &season)
+
+		if cb(season) {
+			break
+		}
+	}
+}
+
+// SetSeasonStanding archives the final leaderboard position of an address in a season
+func (k Keeper) SetSeasonStanding(ctx sdk.Context, seasonID uint64, entry types.LeaderboardEntry) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&entry)
+
+	if err := store.Set(types.SeasonStandingKey(seasonID, entry.Rank), bz); err != nil {
+		panic(err)
+	}
+}
+
+// IterateSeasonStandings iterates over the archived standings of all seasons in (season, rank)
+// order until cb returns true
+func (k Keeper) IterateSeasonStandings(ctx sdk.Context, cb func(seasonID uint64, entry types.LeaderboardEntry) (stop bool)) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	iterator, err := store.Iterator(types.SeasonStandingPrefix, storetypes.PrefixEndBytes(types.SeasonStandingPrefix))
+	if err != nil {
+		panic(err)
+	}
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var entry types.LeaderboardEntry
+		k.cdc.MustUnmarshal(iterator.Value(), &entry)
+		seasonID := binary.BigEndian.Uint64(iterator.Key()[len(types.SeasonStandingPrefix):])
+
+		if cb(seasonID, entry) {
+			break
+		}
+	}
+}
+
+// GetSeasonRolloverState returns the state of the archival of an ended season
+func (k Keeper) GetSeasonRolloverState(ctx sdk.Context) types.SeasonRolloverState {
+	store := k.storeService.OpenKVStore(ctx)
+
+	bz, err := store.Get(types.SeasonRolloverKey)
+	if err != nil || bz == nil {
+		return types.SeasonRolloverState{}
+	}
+
+	var state types.SeasonRolloverState
+	k.cdc.MustUnmarshal(bz, &state)
+
+	return state
+}
+
+// SetSeasonRolloverState sets the state of the archival of an ended season; a zero season ID
+// clears it
+func (k Keeper) SetSeasonRolloverState(ctx sdk.Context, state types.SeasonRolloverState) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	if state.SeasonId == 0 {
+		if err := store.Delete(types.SeasonRolloverKey); err != nil {
+			panic(err)
+		}
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&state)
+	if err := store.Set(types.SeasonRolloverKey, bz); err != nil {
+		panic(err)
+	}
+}
+
+// checkSeasonRollover returns an error while an ended season is being archived, since its
+// standings are taken from the live balances
+func (k Keeper) checkSeasonRollover(ctx sdk.Context) error {
+	if state := k.GetSeasonRolloverState(ctx); state.SeasonId != 0 {
+		return errorsmod.Wrapf(types.ErrSeasonRollover, "season %d is still being archived", state.SeasonId)
+	}
+	return nil
+}
+
+// ApplySeasonRollover is called from the BeginBlocker. Once the running season has ended its
+// leaderboard is archived as the season standings and every live balance is reset to zero;
+// lifetime totals, category balances and period buckets are kept. Each block archives at most
+// params.season_accounts_per_block balances, top of the leaderboard first, and balances cannot
+// change until the archival is done. A new season then starts at the block time the archival
+// completes if seasons are enabled, so a param change applies from the next season.
+func (k Keeper) ApplySeasonRollover(ctx sdk.Context) {
+	now := ctx.BlockTime().Unix()
+
+	if state := k.GetSeasonRolloverState(ctx); state.SeasonId != 0 {
+		if !k.archiveSeason(ctx, state) {
+			return
+		}
+	} else if season, found := k.GetCurrentSeason(ctx); found {
+		if now < season.EndTime {
+			return
+		}
+		if !k.endSeason(ctx, season) {
+			return
+		}
+	}
+
+	length := k.GetParams(ctx).SeasonLengthSeconds
+	if length == 0 {
+		return
+	}
+
+	next := types.Season{
+		Id:        k.lastSeasonID(ctx) + 1,
+		StartTime: now,
+		EndTime:   now + int64(length),
+	}
+	k.SetCurrentSeason(ctx, next)
+
+	// Legacy event, deprecated in favour of EventSeasonStarted
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.ModuleName,
+			sdk.NewAttribute("action", "start_season"),
+			sdk.NewAttribute("season_id", fmt.Sprintf("%d", next.Id)),
+			sdk.NewAttribute("end_time", fmt.Sprintf("%d", next.EndTime)),
+		),
+	)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSeasonStarted{SeasonId: next.Id, EndTime: next.EndTime}); err != nil {
+		panic(err)
+	}
+}
+
+// endSeason clears the running season and starts archiving its standings. It reports whether
+// the archival is already complete.
+func (k Keeper) endSeason(ctx sdk.Context, season types.Season) bool {
+	k.SetSeason(ctx, season)
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.CurrentSeasonKey); err != nil {
+		panic(err)
+	}
+
+	return k.archiveSeason(ctx, types.SeasonRolloverState{SeasonId: season.Id})
+}
+
+// archiveSeason runs one step of the archival of an ended season and reports whether it is complete
+func (k Keeper) archiveSeason(ctx sdk.Context, state types.SeasonRolloverState) bool {
+	limit := k.GetParams(ctx).SeasonAccountsPerBlock
+
+	// Every address on the leaderboard has a non-zero balance and nobody else does. Resetting a
+	// balance drops it from the leaderboard, so each batch is the top of what is left; one extra
+	// entry tells whether anything remains after this batch.
+	batch := k.GetLeaderboard(ctx, limit+1)
+	done := uint32(len(batch)) <= limit
+	if !done {
+		batch = batch[:limit]
+	}
+
+	for _, entry := range batch {
+		state.Archived++
+		entry.Rank = state.Archived
+		k.SetSeasonStanding(ctx, state.SeasonId, entry)
+		k.SetKudosBalance(ctx, entry.Address, 0)
+	}
+
+	if !done {
+		k.SetSeasonRolloverState(ctx, state)
+		return false
+	}
+	k.SetSeasonRolloverState(ctx, types.SeasonRolloverState{})
+
+	// Legacy event, deprecated in favour of EventSeasonEnded
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.ModuleName,
+			sdk.NewAttribute("action", "end_season"),
+			sdk.NewAttribute("season_id", fmt.Sprintf("%d", state.SeasonId)),
+			sdk.NewAttribute("standings", fmt.Sprintf("%d", state.Archived)),
+		),
+	)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventSeasonEnded{SeasonId: state.SeasonId, Standings: state.Archived}); err != nil {
+		panic(err)
+	}
+
+	return true
+}
+
+// lastSeasonID returns the highest ended season ID, or 0 before the first season has ended
+func (k Keeper) lastSeasonID(ctx sdk.Context) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+
+	iterator, err := store.ReverseIterator(types.SeasonPrefix, storetypes.PrefixEndBytes(types.SeasonPrefix))
+	if err != nil {
+		panic(err)
+	}
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(iterator.Key()[len(types.SeasonPrefix):])
+}
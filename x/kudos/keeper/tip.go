@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+// validateTip checks an optional tip against the allowed denoms and the recipient without
+// touching state; an empty tip is always valid
+func (k Keeper) validateTip(ctx sdk.Context, fromAddress, toAddress string, tip sdk.Coins) error {
+	if tip.Empty() {
+		return nil
+	}
+
+	if k.bankKeeper == nil {
+		return errorsmod.Wrap(types.ErrInvalidTip, "tips are not supported on this chain")
+	}
+
+	if !tip.IsValid() {
+		return errorsmod.Wrapf(types.ErrInvalidTip, "%s", tip)
+	}
+
+	params := k.GetParams(ctx)
+	for _, coin := range tip {
+		if !params.IsTipDenomAllowed(coin.Denom) {
+			return errorsmod.Wrapf(types.ErrInvalidTip, "denom %s is not allowed as a tip", coin.Denom)
+		}
+	}
+
+	if _, err := sdk.AccAddressFromBech32(fromAddress); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidAddress, "invalid from address: %s", err)
+	}
+
+	to, err := sdk.AccAddressFromBech32(toAddress)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidAddress, "invalid to address: %s", err)
+	}
+
+	if k.bankKeeper.BlockedAddr(to) {
+		return errorsmod.Wrapf(types.ErrInvalidTip, "%s is not allowed to receive tips", toAddress)
+	}
+
+	return nil
+}
+
+// sendTip transfers a validated tip from sender to recipient through x/bank
+func (k Keeper) sendTip(ctx sdk.Context, fromAddress, toAddress string, tip sdk.Coins) error {
+	if tip.Empty() {
+		return nil
+	}
+
+	from, err := sdk.AccAddressFromBech32(fromAddress)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidAddress, "invalid from address: %s", err)
+	}
+
+	to, err := sdk.AccAddressFromBech32(toAddress)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidAddress, "invalid to address: %s", err)
+	}
+
+	return k.bankKeeper.SendCoins(ctx, from, to, tip)
+}
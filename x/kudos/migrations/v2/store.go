@@ -0,0 +1,78 @@
+package v2
+
+import (
+	"cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+// MigrateStore performs in-place store migrations from v1 to v2:
+//
+//   - v1 history entries were timestamped with the validator's wall clock and carry no
+//     block height, so the original block time cannot be recovered; they are flagged
+//     as legacy instead
+//   - the sender and recipient history indexes are backfilled for all entries
+//   - v1 kept its limits in code and stored no params, so the default params are written
+//     unless params are already present
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	kvStore := storeService.OpenKVStore(ctx)
+
+	iterator, err := kvStore.Iterator(types.KudosHistoryPrefix, storetypes.PrefixEndBytes(types.KudosHistoryPrefix))
+	if err != nil {
+		return err
+	}
+
+	var entries []types.KudosHistory
+	for ; iterator.Valid(); iterator.Next() {
+		var history types.KudosHistory
+		if err := cdc.Unmarshal(iterator.Value(), &history); err != nil {
+			iterator.Close()
+			return err
+		}
+		history.Id = sdk.BigEndianToUint64(iterator.Key()[len(types.KudosHistoryPrefix):])
+		entries = append(entries, history)
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for _, history := range entries {
+		if history.BlockHeight == 0 {
+			history.Legacy = true
+		}
+
+		bz, err := cdc.Marshal(&history)
+		if err != nil {
+			return err
+		}
+
+		if err := kvStore.Set(types.KudosHistoryKey(history.Id), bz); err != nil {
+			return err
+		}
+		if err := kvStore.Set(types.HistoryBySenderKey(history.FromAddress, history.Id), []byte{}); err != nil {
+			return err
+		}
+		if err := kvStore.Set(types.HistoryByRecipientKey(history.ToAddress, history.Id), []byte{}); err != nil {
+			return err
+		}
+	}
+
+	has, err := kvStore.Has(types.ParamsKey)
+	if err != nil {
+		return err
+	}
+	if has {
+		return nil
+	}
+
+	params := types.DefaultParams()
+	bz, err := cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	return kvStore.Set(types.ParamsKey, bz)
+}
@@ -0,0 +1,91 @@
+package v2_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	v2 "github.com/pavlenkotm/cosmos-kudos-module/x/kudos/migrations/v2"
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	storeService := runtime.NewKVStoreService(storeKey)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	// Write history the way v1 did: wall clock timestamp, no block metadata, no indexes
+	kvStore := storeService.OpenKVStore(ctx)
+	v1Entries := []types.KudosHistory{
+		{FromAddress: "cosmos1alice", ToAddress: "cosmos1bob", Amount: 5, Timestamp: 1690000000},
+		{FromAddress: "cosmos1bob", ToAddress: "cosmos1alice", Amount: 3, Timestamp: 1690000100},
+	}
+	for i, entry := range v1Entries {
+		bz := cdc.MustMarshal(&entry)
+		require.NoError(t, kvStore.Set(types.KudosHistoryKey(uint64(i+1)), bz))
+	}
+
+	require.NoError(t, v2.MigrateStore(ctx, storeService, cdc))
+
+	for i, original := range v1Entries {
+		id := uint64(i + 1)
+
+		bz, err := kvStore.Get(types.KudosHistoryKey(id))
+		require.NoError(t, err)
+
+		var migrated types.KudosHistory
+		cdc.MustUnmarshal(bz, &migrated)
+		require.True(t, migrated.Legacy)
+		require.Equal(t, id, migrated.Id)
+		require.Equal(t, original.Timestamp, migrated.Timestamp)
+		require.Equal(t, original.Amount, migrated.Amount)
+
+		has, err := kvStore.Has(types.HistoryBySenderKey(original.FromAddress, id))
+		require.NoError(t, err)
+		require.True(t, has)
+
+		has, err = kvStore.Has(types.HistoryByRecipientKey(original.ToAddress, id))
+		require.NoError(t, err)
+		require.True(t, has)
+	}
+}
+
+func TestMigrateStoreWritesDefaultParams(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	storeService := runtime.NewKVStoreService(storeKey)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	// A v1 store holds balances but no params
+	kvStore := storeService.OpenKVStore(ctx)
+	require.NoError(t, kvStore.Set(types.KudosBalanceKey("cosmos1bob"), sdk.Uint64ToBigEndian(5)))
+
+	require.NoError(t, v2.MigrateStore(ctx, storeService, cdc))
+
+	bz, err := kvStore.Get(types.ParamsKey)
+	require.NoError(t, err)
+	require.NotNil(t, bz)
+
+	var params types.Params
+	cdc.MustUnmarshal(bz, &params)
+	require.Equal(t, types.DefaultParams(), params)
+
+	// Params that are already stored are kept
+	params.DailyLimit = 7
+	require.NoError(t, kvStore.Set(types.ParamsKey, cdc.MustMarshal(&params)))
+	require.NoError(t, v2.MigrateStore(ctx, storeService, cdc))
+
+	bz, err = kvStore.Get(types.ParamsKey)
+	require.NoError(t, err)
+	cdc.MustUnmarshal(bz, &params)
+	require.Equal(t, uint64(7), params.DailyLimit)
+}
@@ -0,0 +1,40 @@
+package v3
+
+import (
+	"cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+// MigrateStore performs in-place store migrations from v2 to v3 by building the
+// (inverted balance, address) leaderboard index from the existing balances.
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService) error {
+	kvStore := storeService.OpenKVStore(ctx)
+
+	iterator, err := kvStore.Iterator(types.KudosBalancePrefix, storetypes.PrefixEndBytes(types.KudosBalancePrefix))
+	if err != nil {
+		return err
+	}
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		address := string(iterator.Key()[len(types.KudosBalancePrefix):])
+		balance := sdk.BigEndianToUint64(iterator.Value())
+		if balance > 0 {
+			keys = append(keys, types.LeaderboardKey(address, balance))
+		}
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := kvStore.Set(key, []byte{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,42 @@
+package v3_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	v3 "github.com/pavlenkotm/cosmos-kudos-module/x/kudos/migrations/v3"
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	storeService := runtime.NewKVStoreService(storeKey)
+
+	// Write balances the way v2 did, without a leaderboard index
+	kvStore := storeService.OpenKVStore(ctx)
+	balances := map[string]uint64{"cosmos1alice": 10, "cosmos1bob": 25, "cosmos1zero": 0}
+	for address, balance := range balances {
+		require.NoError(t, kvStore.Set(types.KudosBalanceKey(address), sdk.Uint64ToBigEndian(balance)))
+	}
+
+	require.NoError(t, v3.MigrateStore(ctx, storeService))
+
+	iterator, err := kvStore.Iterator(types.LeaderboardPrefix, storetypes.PrefixEndBytes(types.LeaderboardPrefix))
+	require.NoError(t, err)
+	defer iterator.Close()
+
+	var addresses []string
+	for ; iterator.Valid(); iterator.Next() {
+		address, balance := types.ParseLeaderboardKey(iterator.Key()[len(types.LeaderboardPrefix):])
+		require.Equal(t, balances[address], balance)
+		addresses = append(addresses, address)
+	}
+	require.Equal(t, []string{"cosmos1bob", "cosmos1alice"}, addresses)
+}
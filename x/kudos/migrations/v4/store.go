@@ -0,0 +1,54 @@
+package v4
+
+import (
+	"cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+// MigrateStore performs in-place store migrations from v3 to v4 by computing each
+// sender's lifetime total sent from the history and building the giver leaderboard index.
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	kvStore := storeService.OpenKVStore(ctx)
+
+	iterator, err := kvStore.Iterator(types.KudosHistoryPrefix, storetypes.PrefixEndBytes(types.KudosHistoryPrefix))
+	if err != nil {
+		return err
+	}
+
+	// Senders are kept in first-seen history order so writes are deterministic
+	var senders []string
+	totals := make(map[string]uint64)
+	for ; iterator.Valid(); iterator.Next() {
+		var history types.KudosHistory
+		if err := cdc.Unmarshal(iterator.Value(), &history); err != nil {
+			iterator.Close()
+			return err
+		}
+		if _, ok := totals[history.FromAddress]; !ok {
+			senders = append(senders, history.FromAddress)
+		}
+		totals[history.FromAddress] += history.Amount
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for _, sender := range senders {
+		total := totals[sender]
+		if total == 0 {
+			continue
+		}
+		if err := kvStore.Set(types.KudosSentTotalKey(sender), sdk.Uint64ToBigEndian(total)); err != nil {
+			return err
+		}
+		if err := kvStore.Set(types.GiverLeaderboardKey(sender, total), []byte{}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package v4_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	v4 "github.com/pavlenkotm/cosmos-kudos-module/x/kudos/migrations/v4"
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	storeService := runtime.NewKVStoreService(storeKey)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	// Write history the way v3 did, without sent totals
+	kvStore := storeService.OpenKVStore(ctx)
+	history := []types.KudosHistory{
+		{Id: 1, FromAddress: "cosmos1alice", ToAddress: "cosmos1bob", Amount: 10},
+		{Id: 2, FromAddress: "cosmos1bob", ToAddress: "cosmos1alice", Amount: 30},
+		{Id: 3, FromAddress: "cosmos1alice", ToAddress: "cosmos1carol", Amount: 5},
+	}
+	for _, entry := range history {
+		require.NoError(t, kvStore.Set(types.KudosHistoryKey(entry.Id), cdc.MustMarshal(&entry)))
+	}
+
+	require.NoError(t, v4.MigrateStore(ctx, storeService, cdc))
+
+	total, err := kvStore.Get(types.KudosSentTotalKey("cosmos1alice"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(15), sdk.BigEndianToUint64(total))
+
+	iterator, err := kvStore.Iterator(types.GiverLeaderboardPrefix, storetypes.PrefixEndBytes(types.GiverLeaderboardPrefix))
+	require.NoError(t, err)
+	defer iterator.Close()
+
+	var addresses []string
+	for ; iterator.Valid(); iterator.Next() {
+		address, _ := types.ParseLeaderboardKey(iterator.Key()[len(types.GiverLeaderboardPrefix):])
+		addresses = append(addresses, address)
+	}
+	require.Equal(t, []string{"cosmos1bob", "cosmos1alice"}, addresses)
+}
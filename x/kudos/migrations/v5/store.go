@@ -0,0 +1,52 @@
+package v5
+
+import (
+	"time"
+
+	"cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+// MigrateStore performs in-place store migrations from v4 to v5 by aggregating the
+// existing history into per-day received buckets for windowed leaderboards. Legacy
+// entries are bucketed by their recorded timestamp.
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	kvStore := storeService.OpenKVStore(ctx)
+
+	iterator, err := kvStore.Iterator(types.KudosHistoryPrefix, storetypes.PrefixEndBytes(types.KudosHistoryPrefix))
+	if err != nil {
+		return err
+	}
+
+	// Buckets are kept in first-seen history order so writes are deterministic
+	var keys []string
+	amounts := make(map[string]uint64)
+	for ; iterator.Valid(); iterator.Next() {
+		var history types.KudosHistory
+		if err := cdc.Unmarshal(iterator.Value(), &history); err != nil {
+			iterator.Close()
+			return err
+		}
+		day := types.PeriodDay(time.Unix(history.Timestamp, 0))
+		key := string(types.PeriodBucketKey(day, history.ToAddress))
+		if _, ok := amounts[key]; !ok {
+			keys = append(keys, key)
+		}
+		amounts[key] += history.Amount
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := kvStore.Set([]byte(key), sdk.Uint64ToBigEndian(amounts[key])); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
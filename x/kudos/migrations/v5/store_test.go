@@ -0,0 +1,46 @@
+package v5_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	v5 "github.com/pavlenkotm/cosmos-kudos-module/x/kudos/migrations/v5"
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	storeService := runtime.NewKVStoreService(storeKey)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	// Write history the way v4 did, without period buckets
+	const day = 19700
+	kvStore := storeService.OpenKVStore(ctx)
+	history := []types.KudosHistory{
+		{Id: 1, FromAddress: "cosmos1alice", ToAddress: "cosmos1bob", Amount: 10, Timestamp: day * types.PeriodBucketSeconds},
+		{Id: 2, FromAddress: "cosmos1carol", ToAddress: "cosmos1bob", Amount: 4, Timestamp: day*types.PeriodBucketSeconds + 60},
+		{Id: 3, FromAddress: "cosmos1alice", ToAddress: "cosmos1bob", Amount: 5, Timestamp: (day + 1) * types.PeriodBucketSeconds},
+	}
+	for _, entry := range history {
+		require.NoError(t, kvStore.Set(types.KudosHistoryKey(entry.Id), cdc.MustMarshal(&entry)))
+	}
+
+	require.NoError(t, v5.MigrateStore(ctx, storeService, cdc))
+
+	bz, err := kvStore.Get(types.PeriodBucketKey(day, "cosmos1bob"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(14), sdk.BigEndianToUint64(bz))
+
+	bz, err = kvStore.Get(types.PeriodBucketKey(day+1, "cosmos1bob"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), sdk.BigEndianToUint64(bz))
+}
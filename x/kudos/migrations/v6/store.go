@@ -0,0 +1,36 @@
+package v6
+
+import (
+	"cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+// MigrateStore performs in-place store migrations from v5 to v6 by setting the new
+// revoke grace period param, which decodes as 0 (revocation disabled) from v5 params.
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	kvStore := storeService.OpenKVStore(ctx)
+
+	bz, err := kvStore.Get(types.ParamsKey)
+	if err != nil {
+		return err
+	}
+	if bz == nil {
+		return nil
+	}
+
+	var params types.Params
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+	params.RevokeGracePeriodSeconds = types.DefaultRevokeGracePeriodSeconds
+
+	bz, err = cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	return kvStore.Set(types.ParamsKey, bz)
+}
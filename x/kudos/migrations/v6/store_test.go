@@ -0,0 +1,39 @@
+package v6_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	"github.com/stretchr/testify/require"
+
+	v6 "github.com/pavlenkotm/cosmos-kudos-module/x/kudos/migrations/v6"
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	storeService := runtime.NewKVStoreService(storeKey)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	// Write params the way v5 did, without a revoke grace period
+	kvStore := storeService.OpenKVStore(ctx)
+	oldParams := types.Params{DailyLimit: 50, QuotaWindowSeconds: 3600, MaxCommentLength: 280, DefaultLeaderboardLimit: 25}
+	require.NoError(t, kvStore.Set(types.ParamsKey, cdc.MustMarshal(&oldParams)))
+
+	require.NoError(t, v6.MigrateStore(ctx, storeService, cdc))
+
+	bz, err := kvStore.Get(types.ParamsKey)
+	require.NoError(t, err)
+	var params types.Params
+	cdc.MustUnmarshal(bz, &params)
+
+	expected := oldParams
+	expected.RevokeGracePeriodSeconds = types.DefaultRevokeGracePeriodSeconds
+	require.Equal(t, expected, params)
+}
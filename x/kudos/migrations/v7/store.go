@@ -0,0 +1,62 @@
+package v7
+
+import (
+	"cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+// MigrateStore performs in-place store migrations from v6 to v7. Balances have never
+// decayed before v7, so every balance is copied into the new lifetime received total.
+// The stored params get the default decay epoch and batch size with decay left disabled,
+// and the default season batch size with seasons left disabled.
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	kvStore := storeService.OpenKVStore(ctx)
+
+	iterator, err := kvStore.Iterator(types.KudosBalancePrefix, storetypes.PrefixEndBytes(types.KudosBalancePrefix))
+	if err != nil {
+		return err
+	}
+
+	var addresses []string
+	var balances [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		addresses = append(addresses, string(iterator.Key()[len(types.KudosBalancePrefix):]))
+		balances = append(balances, iterator.Value())
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for i, address := range addresses {
+		if err := kvStore.Set(types.KudosLifetimeKey(address), balances[i]); err != nil {
+			return err
+		}
+	}
+
+	bz, err := kvStore.Get(types.ParamsKey)
+	if err != nil {
+		return err
+	}
+	if bz == nil {
+		return nil
+	}
+
+	var params types.Params
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+	params.DecayEpochSeconds = types.DefaultDecayEpochSeconds
+	params.DecayAccountsPerBlock = types.DefaultDecayAccountsPerBlock
+	params.SeasonAccountsPerBlock = types.DefaultSeasonAccountsPerBlock
+
+	bz, err = cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	return kvStore.Set(types.ParamsKey, bz)
+}
@@ -0,0 +1,52 @@
+package v7_test
+
+import (
+	"testing"
+
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	v7 "github.com/pavlenkotm/cosmos-kudos-module/x/kudos/migrations/v7"
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+func TestMigrateStore(t *testing.T) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tKey := storetypes.NewTransientStoreKey("transient_test")
+	ctx := testutil.DefaultContext(storeKey, tKey)
+	storeService := runtime.NewKVStoreService(storeKey)
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	// Write balances and params the way v6 did, without lifetime totals, decay or season params
+	kvStore := storeService.OpenKVStore(ctx)
+	require.NoError(t, kvStore.Set(types.KudosBalanceKey("cosmos1alice"), sdk.Uint64ToBigEndian(7)))
+	require.NoError(t, kvStore.Set(types.KudosBalanceKey("cosmos1bob"), sdk.Uint64ToBigEndian(12)))
+	oldParams := types.Params{DailyLimit: 50, QuotaWindowSeconds: 3600, MaxCommentLength: 280, DefaultLeaderboardLimit: 25, RevokeGracePeriodSeconds: 600}
+	require.NoError(t, kvStore.Set(types.ParamsKey, cdc.MustMarshal(&oldParams)))
+
+	require.NoError(t, v7.MigrateStore(ctx, storeService, cdc))
+
+	bz, err := kvStore.Get(types.KudosLifetimeKey("cosmos1alice"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(7), sdk.BigEndianToUint64(bz))
+
+	bz, err = kvStore.Get(types.KudosLifetimeKey("cosmos1bob"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(12), sdk.BigEndianToUint64(bz))
+
+	bz, err = kvStore.Get(types.ParamsKey)
+	require.NoError(t, err)
+	var params types.Params
+	cdc.MustUnmarshal(bz, &params)
+
+	expected := oldParams
+	expected.DecayEpochSeconds = types.DefaultDecayEpochSeconds
+	expected.DecayAccountsPerBlock = types.DefaultDecayAccountsPerBlock
+	expected.SeasonAccountsPerBlock = types.DefaultSeasonAccountsPerBlock
+	require.Equal(t, expected, params)
+}
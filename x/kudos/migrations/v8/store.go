@@ -0,0 +1,38 @@
+package v8
+
+import (
+	"cosmossdk.io/core/store"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+// MigrateStore performs in-place store migrations from v7 to v8. The stored params get the
+// default budget allowance and epoch with budget mode left disabled, so sending stays unlimited
+// apart from the daily quota until governance enables it.
+func MigrateStore(ctx sdk.Context, storeService store.KVStoreService, cdc codec.BinaryCodec) error {
+	kvStore := storeService.OpenKVStore(ctx)
+
+	bz, err := kvStore.Get(types.ParamsKey)
+	if err != nil {
+		return err
+	}
+	if bz == nil {
+		return nil
+	}
+
+	var params types.Params
+	if err := cdc.Unmarshal(bz, &params); err != nil {
+		return err
+	}
+	params.BudgetPerEpoch = types.DefaultBudgetPerEpoch
+	params.BudgetEpochSeconds = types.DefaultBudgetEpochSeconds
+
+	bz, err = cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	return kvStore.Set(types.ParamsKey, bz)
+}
@@ -0,0 +1,244 @@
+package kudos_test
+
+import (
+	"context"
+	"testing"
+
+	runtimev1alpha1 "cosmossdk.io/api/cosmos/app/runtime/v1alpha1"
+	appv1alpha1 "cosmossdk.io/api/cosmos/app/v1alpha1"
+	authmodulev1 "cosmossdk.io/api/cosmos/auth/module/v1"
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+	bankmodulev1 "cosmossdk.io/api/cosmos/bank/module/v1"
+	consensusmodulev1 "cosmossdk.io/api/cosmos/consensus/module/v1"
+	genutilmodulev1 "cosmossdk.io/api/cosmos/genutil/module/v1"
+	stakingmodulev1 "cosmossdk.io/api/cosmos/staking/module/v1"
+	txconfigv1 "cosmossdk.io/api/cosmos/tx/config/v1"
+	"cosmossdk.io/client/v2/autocli"
+	"cosmossdk.io/core/appconfig"
+	"cosmossdk.io/depinject"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/testutil"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	_ "github.com/cosmos/cosmos-sdk/x/auth"
+	_ "github.com/cosmos/cosmos-sdk/x/auth/tx/config"
+	_ "github.com/cosmos/cosmos-sdk/x/bank"
+	_ "github.com/cosmos/cosmos-sdk/x/consensus"
+	_ "github.com/cosmos/cosmos-sdk/x/genutil"
+	_ "github.com/cosmos/cosmos-sdk/x/staking"
+
+	kudosapi "github.com/pavlenkotm/cosmos-kudos-module/api/kudos"
+	modulev1 "github.com/pavlenkotm/cosmos-kudos-module/api/kudos/module/v1"
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos"
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/keeper"
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+// appConfig is a minimal app_config.go style chain with the kudos module wired in next to the
+// SDK modules it depends on
+func appConfig(kudosConfig *modulev1.Module) depinject.Config {
+	return appconfig.Compose(&appv1alpha1.Config{
+		Modules: []*appv1alpha1.ModuleConfig{
+			{
+				Name: "runtime",
+				Config: appconfig.WrapAny(&runtimev1alpha1.Module{
+					AppName:       "KudosTestApp",
+					BeginBlockers: []string{"staking", "kudos"},
+					EndBlockers:   []string{"staking"},
+					InitGenesis:   []string{"auth", "bank", "staking", "genutil", "consensus", "kudos"},
+					OverrideStoreKeys: []*runtimev1alpha1.StoreKeyConfig{
+						{ModuleName: "auth", KvStoreKey: "acc"},
+					},
+				}),
+			},
+			{
+				Name: "auth",
+				Config: appconfig.WrapAny(&authmodulev1.Module{
+					Bech32Prefix: "cosmos",
+					ModuleAccountPermissions: []*authmodulev1.ModuleAccountPermission{
+						{Account: "fee_collector"},
+						{Account: "bonded_tokens_pool", Permissions: []string{"burner", "staking"}},
+						{Account: "not_bonded_tokens_pool", Permissions: []string{"burner", "staking"}},
+						{Account: types.ModuleName},
+					},
+				}),
+			},
+			{Name: "bank", Config: appconfig.WrapAny(&bankmodulev1.Module{})},
+			{Name: "staking", Config: appconfig.WrapAny(&stakingmodulev1.Module{})},
+			{Name: "genutil", Config: appconfig.WrapAny(&genutilmodulev1.Module{})},
+			{Name: "consensus", Config: appconfig.WrapAny(&consensusmodulev1.Module{})},
+			{Name: "tx", Config: appconfig.WrapAny(&txconfigv1.Config{})},
+			{Name: types.ModuleName, Config: appconfig.WrapAny(kudosConfig)},
+		},
+	})
+}
+
+func TestAppWiring(t *testing.T) {
+	var k keeper.Keeper
+	app, err := simtestutil.Setup(depinject.Configs(appConfig(&modulev1.Module{}), depinject.Supply(log.NewNopLogger())), &k)
+	require.NoError(t, err)
+
+	require.Contains(t, app.ModuleManager.Modules, types.ModuleName)
+	require.Equal(t, authtypes.NewModuleAddress("gov").String(), k.GetAuthority())
+
+	ctx := app.BaseApp.NewContext(false)
+
+	alice := sdk.AccAddress("alice_______________").String()
+	bob := sdk.AccAddress("bob_________________").String()
+	require.NoError(t, k.SendKudos(ctx, alice, bob, 3, "wired", 0, nil))
+	require.Equal(t, uint64(3), k.GetKudosBalance(ctx, bob))
+	require.True(t, k.GetRewardPool(ctx).Empty())
+
+	// tips go through the injected x/bank keeper, which alice has no coins in
+	params := k.GetParams(ctx)
+	params.AllowedTipDenoms = []string{sdk.DefaultBondDenom}
+	k.SetParams(ctx, params)
+	err = k.SendKudos(ctx, alice, bob, 1, "", 0, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+}
+
+func TestAppWiringCustomAuthority(t *testing.T) {
+	authority := sdk.AccAddress("council_____________").String()
+
+	var k keeper.Keeper
+	_, err := simtestutil.Setup(depinject.Configs(appConfig(&modulev1.Module{Authority: authority}), depinject.Supply(log.NewNopLogger())), &k)
+	require.NoError(t, err)
+	require.Equal(t, authority, k.GetAuthority())
+}
+
+// orderHooks records the name of the module it belongs to in hookCalls whenever kudos are sent
+type orderHooks string
+
+var hookCalls []string
+
+func (orderHooks) BeforeKudosSent(context.Context, string, string, uint64, uint64) error {
+	return nil
+}
+
+func (h orderHooks) AfterKudosSent(context.Context, types.KudosHistory) error {
+	hookCalls = append(hookCalls, string(h))
+	return nil
+}
+
+func (orderHooks) AfterKudosRevoked(context.Context, types.KudosHistory) error {
+	return nil
+}
+
+func ProvideBadgesHooks() types.KudosHooksWrapper {
+	return types.KudosHooksWrapper{KudosHooks: orderHooks("badges")}
+}
+
+func ProvideRewardsHooks() types.KudosHooksWrapper {
+	return types.KudosHooksWrapper{KudosHooks: orderHooks("rewards")}
+}
+
+func TestAppWiringHooks(t *testing.T) {
+	hookCalls = nil
+
+	var k keeper.Keeper
+	app, err := simtestutil.Setup(depinject.Configs(
+		appConfig(&modulev1.Module{}),
+		depinject.Supply(log.NewNopLogger()),
+		depinject.ProvideInModule("rewards", ProvideRewardsHooks),
+		depinject.ProvideInModule("badges", ProvideBadgesHooks),
+	), &k)
+	require.NoError(t, err)
+
+	// the hooks are registered after the module took its copy of the keeper, and still reach it
+	ctx := app.BaseApp.NewContext(false)
+	alice := sdk.AccAddress("alice_______________").String()
+	bob := sdk.AccAddress("bob_________________").String()
+	require.NoError(t, k.SendKudos(ctx, alice, bob, 1, "", 0, nil))
+	require.Equal(t, []string{"badges", "rewards"}, hookCalls)
+	require.Panics(t, func() { k.SetHooks(types.MultiKudosHooks{}) })
+}
+
+// moduleInputs returns the inputs app wiring hands to ProvideModule, without any optional keepers
+func moduleInputs(t *testing.T) (kudos.ModuleInputs, sdk.Context) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	tStoreKey := storetypes.NewTransientStoreKey(types.TStoreKey)
+	testCtx := testutil.DefaultContextWithDB(t, storeKey, tStoreKey)
+
+	return kudos.ModuleInputs{
+		Config:            &modulev1.Module{},
+		Cdc:               codec.NewProtoCodec(codectypes.NewInterfaceRegistry()),
+		StoreService:      runtime.NewKVStoreService(storeKey),
+		TransientStoreKey: tStoreKey,
+		Logger:            log.NewNopLogger(),
+	}, testCtx.Ctx
+}
+
+func TestProvideModuleWithoutBank(t *testing.T) {
+	in, ctx := moduleInputs(t)
+	out := kudos.ProvideModule(in)
+	k := out.KudosKeeper
+	require.NotNil(t, out.Module)
+
+	params := types.DefaultParams()
+	params.RedeemDenom = "stake"
+	params.RedeemRate = 1
+	k.SetParams(ctx, params)
+
+	alice := sdk.AccAddress("alice_______________").String()
+	bob := sdk.AccAddress("bob_________________").String()
+
+	// plain transfers work without x/bank, everything that moves coins is rejected
+	require.NoError(t, k.SendKudos(ctx, alice, bob, 2, "", 0, nil))
+	require.ErrorIs(t, k.SendKudos(ctx, alice, bob, 1, "", 0, sdk.NewCoins(sdk.NewInt64Coin("stake", 1))), types.ErrInvalidTip)
+	require.ErrorIs(t, k.FundRewardPool(ctx, alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 1))), types.ErrRedeemDisabled)
+	_, err := k.RedeemKudos(ctx, bob, 1)
+	require.ErrorIs(t, err, types.ErrRedeemDisabled)
+	require.True(t, k.GetRewardPool(ctx).Empty())
+}
+
+// bankKeeperStub satisfies types.BankKeeper; ProvideModule only checks whether one is present
+type bankKeeperStub struct{ types.BankKeeper }
+
+// accountKeeperStub knows no module accounts
+type accountKeeperStub struct{}
+
+func (accountKeeperStub) GetModuleAddress(string) sdk.AccAddress { return nil }
+
+func (accountKeeperStub) GetAccount(context.Context, sdk.AccAddress) sdk.AccountI { return nil }
+
+func TestProvideModuleMissingModuleAccount(t *testing.T) {
+	in, _ := moduleInputs(t)
+	in.BankKeeper = bankKeeperStub{}
+	in.AccountKeeper = accountKeeperStub{}
+
+	require.PanicsWithValue(t, "the x/kudos module account has not been set", func() { kudos.ProvideModule(in) })
+}
+
+func TestAutoCLIOptionsCoverEveryRPC(t *testing.T) {
+	var module autocli.HasAutoCLIConfig = kudos.AppModule{}
+	opts := module.AutoCLIOptions()
+
+	for _, tc := range []struct {
+		desc    grpc.ServiceDesc
+		service *autocliv1.ServiceCommandDescriptor
+	}{
+		{kudosapi.Query_ServiceDesc, opts.Query},
+		{kudosapi.Msg_ServiceDesc, opts.Tx},
+	} {
+		require.Equal(t, tc.desc.ServiceName, tc.service.Service)
+
+		described := make(map[string]bool)
+		for _, rpc := range tc.service.RpcCommandOptions {
+			require.False(t, described[rpc.RpcMethod], "%s described twice", rpc.RpcMethod)
+			described[rpc.RpcMethod] = true
+		}
+		for _, method := range tc.desc.Methods {
+			require.True(t, described[method.MethodName], "%s has no AutoCLI options", method.MethodName)
+		}
+		require.Len(t, described, len(tc.desc.Methods))
+	}
+}
@@ -0,0 +1,44 @@
+package simulation
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's Value to the
+// corresponding kudos type: balances, history entries, the history counter and quota records.
+// Values under any other prefix, such as the secondary indexes, are printed as raw bytes.
+func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key[:1], types.KudosBalancePrefix):
+			return fmt.Sprintf("%d\n%d", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.KudosHistoryPrefix):
+			var historyA, historyB types.KudosHistory
+			cdc.MustUnmarshal(kvA.Value, &historyA)
+			cdc.MustUnmarshal(kvB.Value, &historyB)
+			return fmt.Sprintf("%v\n%v", historyA, historyB)
+
+		case bytes.Equal(kvA.Key, types.HistoryCounterKey):
+			return fmt.Sprintf("%d\n%d", binary.BigEndian.Uint64(kvA.Value), binary.BigEndian.Uint64(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.DailySentPrefix):
+			return fmt.Sprintf("%s\n%s", decodeDailySent(kvA.Value), decodeDailySent(kvB.Value))
+
+		default:
+			return fmt.Sprintf("%X\n%X", kvA.Value, kvB.Value)
+		}
+	}
+}
+
+// decodeDailySent formats a quota record, stored as the used amount followed by the reset time
+func decodeDailySent(bz []byte) string {
+	return fmt.Sprintf("used: %d, reset_at: %d", binary.BigEndian.Uint64(bz[:8]), int64(binary.BigEndian.Uint64(bz[8:])))
+}
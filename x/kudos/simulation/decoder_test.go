@@ -0,0 +1,55 @@
+package simulation_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/simulation"
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	dec := simulation.NewDecodeStore(cdc)
+
+	alice := sdk.AccAddress("alice_______________").String()
+	history := types.KudosHistory{Id: 7, FromAddress: alice, Amount: 3, Timestamp: 100}
+
+	dailySent := make([]byte, 16)
+	binary.BigEndian.PutUint64(dailySent[:8], 5)
+	binary.BigEndian.PutUint64(dailySent[8:], 86400)
+
+	kvPairs := kv.Pairs{
+		Pairs: []kv.Pair{
+			{Key: types.KudosBalanceKey(alice), Value: sdk.Uint64ToBigEndian(42)},
+			{Key: types.KudosHistoryKey(7), Value: cdc.MustMarshal(&history)},
+			{Key: types.HistoryCounterKey, Value: sdk.Uint64ToBigEndian(7)},
+			{Key: types.DailySentKey(alice), Value: dailySent},
+			{Key: types.HistoryBySenderKey(alice, 7), Value: []byte{0x01}},
+		},
+	}
+
+	tests := []struct {
+		name        string
+		expectedLog string
+	}{
+		{"Balance", "42\n42"},
+		{"History", fmt.Sprintf("%v\n%v", history, history)},
+		{"HistoryCounter", "7\n7"},
+		{"DailySent", "used: 5, reset_at: 86400\nused: 5, reset_at: 86400"},
+		{"other", "01\n01"},
+	}
+
+	for i, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expectedLog, dec(kvPairs.Pairs[i], kvPairs.Pairs[i]), tt.name)
+		})
+	}
+}
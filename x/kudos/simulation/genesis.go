@@ -0,0 +1,143 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+// Simulation parameter constants
+const (
+	DailyLimit               = "daily_limit"
+	QuotaWindowSeconds       = "quota_window_seconds"
+	MaxCommentLength         = "max_comment_length"
+	DefaultLeaderboardLimit  = "default_leaderboard_limit"
+	RevokeGracePeriodSeconds = "revoke_grace_period_seconds"
+	BudgetEnabled            = "budget_enabled"
+	BudgetPerEpoch           = "budget_per_epoch"
+	AllowedTipDenoms         = "allowed_tip_denoms"
+	Categories               = "categories"
+)
+
+// GenDailyLimit randomized DailyLimit
+func GenDailyLimit(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 1000))
+}
+
+// GenQuotaWindowSeconds randomized QuotaWindowSeconds, between one minute and two days
+func GenQuotaWindowSeconds(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 60, 2*types.DailyQuotaWindowSeconds))
+}
+
+// GenMaxCommentLength randomized MaxCommentLength
+func GenMaxCommentLength(r *rand.Rand) uint32 {
+	return uint32(simtypes.RandIntBetween(r, 1, int(types.DefaultMaxCommentLength)*2))
+}
+
+// GenDefaultLeaderboardLimit randomized DefaultLeaderboardLimit
+func GenDefaultLeaderboardLimit(r *rand.Rand) uint32 {
+	return uint32(simtypes.RandIntBetween(r, 1, 100))
+}
+
+// GenRevokeGracePeriodSeconds randomized RevokeGracePeriodSeconds; revocation is disabled 10% of the time
+func GenRevokeGracePeriodSeconds(r *rand.Rand) uint64 {
+	if r.Intn(10) == 0 {
+		return 0
+	}
+	return uint64(simtypes.RandIntBetween(r, 60, 2*int(types.DefaultRevokeGracePeriodSeconds)))
+}
+
+// GenBudgetEnabled randomized BudgetEnabled; budget mode is enabled 30% of the time
+func GenBudgetEnabled(r *rand.Rand) bool {
+	return r.Intn(10) < 3
+}
+
+// GenBudgetPerEpoch randomized BudgetPerEpoch
+func GenBudgetPerEpoch(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 500))
+}
+
+// GenAllowedTipDenoms randomized AllowedTipDenoms; tips in the bond denom are allowed half of the time
+func GenAllowedTipDenoms(r *rand.Rand, bondDenom string) []string {
+	if r.Intn(2) == 0 {
+		return nil
+	}
+	return []string{bondDenom}
+}
+
+// GenCategories randomized Categories; up to four categories, most of them active
+func GenCategories(r *rand.Rand) []types.Category {
+	categories := make([]types.Category, r.Intn(5))
+	for i := range categories {
+		categories[i] = types.NewCategory(
+			uint64(i+1),
+			simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 1, types.MaxCategoryNameLength)),
+			simtypes.RandStringOfLength(r, r.Intn(types.MaxCategoryDescriptionLength)),
+			r.Intn(5) > 0,
+		)
+	}
+	return categories
+}
+
+// RandomParams returns random params that pass validation. Decay, seasons and redemption stay
+// disabled: they are not driven by the simulated messages.
+func RandomParams(r *rand.Rand, bondDenom string) types.Params {
+	params := types.DefaultParams()
+	params.DailyLimit = GenDailyLimit(r)
+	params.QuotaWindowSeconds = GenQuotaWindowSeconds(r)
+	params.MaxCommentLength = GenMaxCommentLength(r)
+	params.DefaultLeaderboardLimit = GenDefaultLeaderboardLimit(r)
+	params.RevokeGracePeriodSeconds = GenRevokeGracePeriodSeconds(r)
+	params.BudgetEnabled = GenBudgetEnabled(r)
+	params.BudgetPerEpoch = GenBudgetPerEpoch(r)
+	params.AllowedTipDenoms = GenAllowedTipDenoms(r, bondDenom)
+	return params
+}
+
+// RandomizedGenState generates a random GenesisState for kudos
+func RandomizedGenState(simState *module.SimulationState) {
+	var (
+		dailyLimit               uint64
+		quotaWindowSeconds       uint64
+		maxCommentLength         uint32
+		defaultLeaderboardLimit  uint32
+		revokeGracePeriodSeconds uint64
+		budgetEnabled            bool
+		budgetPerEpoch           uint64
+		allowedTipDenoms         []string
+		categories               []types.Category
+	)
+
+	simState.AppParams.GetOrGenerate(DailyLimit, &dailyLimit, simState.Rand, func(r *rand.Rand) { dailyLimit = GenDailyLimit(r) })
+	simState.AppParams.GetOrGenerate(QuotaWindowSeconds, &quotaWindowSeconds, simState.Rand, func(r *rand.Rand) { quotaWindowSeconds = GenQuotaWindowSeconds(r) })
+	simState.AppParams.GetOrGenerate(MaxCommentLength, &maxCommentLength, simState.Rand, func(r *rand.Rand) { maxCommentLength = GenMaxCommentLength(r) })
+	simState.AppParams.GetOrGenerate(DefaultLeaderboardLimit, &defaultLeaderboardLimit, simState.Rand, func(r *rand.Rand) { defaultLeaderboardLimit = GenDefaultLeaderboardLimit(r) })
+	simState.AppParams.GetOrGenerate(RevokeGracePeriodSeconds, &revokeGracePeriodSeconds, simState.Rand, func(r *rand.Rand) { revokeGracePeriodSeconds = GenRevokeGracePeriodSeconds(r) })
+	simState.AppParams.GetOrGenerate(BudgetEnabled, &budgetEnabled, simState.Rand, func(r *rand.Rand) { budgetEnabled = GenBudgetEnabled(r) })
+	simState.AppParams.GetOrGenerate(BudgetPerEpoch, &budgetPerEpoch, simState.Rand, func(r *rand.Rand) { budgetPerEpoch = GenBudgetPerEpoch(r) })
+	simState.AppParams.GetOrGenerate(AllowedTipDenoms, &allowedTipDenoms, simState.Rand, func(r *rand.Rand) { allowedTipDenoms = GenAllowedTipDenoms(r, simState.BondDenom) })
+	simState.AppParams.GetOrGenerate(Categories, &categories, simState.Rand, func(r *rand.Rand) { categories = GenCategories(r) })
+
+	genesis := types.DefaultGenesisState()
+	genesis.Params.DailyLimit = dailyLimit
+	genesis.Params.QuotaWindowSeconds = quotaWindowSeconds
+	genesis.Params.MaxCommentLength = maxCommentLength
+	genesis.Params.DefaultLeaderboardLimit = defaultLeaderboardLimit
+	genesis.Params.RevokeGracePeriodSeconds = revokeGracePeriodSeconds
+	genesis.Params.BudgetEnabled = budgetEnabled
+	genesis.Params.BudgetPerEpoch = budgetPerEpoch
+	genesis.Params.AllowedTipDenoms = allowedTipDenoms
+	genesis.Categories = categories
+
+	bz, err := json.MarshalIndent(&genesis.Params, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated kudos parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
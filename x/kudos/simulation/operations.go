@@ -0,0 +1,263 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/keeper"
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgSendKudos      = "op_weight_msg_send_kudos"
+	OpWeightMsgMultiSendKudos = "op_weight_msg_multi_send_kudos"
+	OpWeightMsgRevokeKudos    = "op_weight_msg_revoke_kudos"
+
+	DefaultWeightMsgSendKudos      = 100
+	DefaultWeightMsgMultiSendKudos = 25
+	DefaultWeightMsgRevokeKudos    = 25
+
+	// maxMultiSendOutputs bounds the recipients of a simulated multi-send, well below types.MaxMultiSendOutputs
+	maxMultiSendOutputs = 5
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(
+	appParams simtypes.AppParams,
+	txGen client.TxConfig,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	k keeper.Keeper,
+) simulation.WeightedOperations {
+	var weightMsgSendKudos, weightMsgMultiSendKudos, weightMsgRevokeKudos int
+	appParams.GetOrGenerate(OpWeightMsgSendKudos, &weightMsgSendKudos, nil, func(_ *rand.Rand) {
+		weightMsgSendKudos = DefaultWeightMsgSendKudos
+	})
+	appParams.GetOrGenerate(OpWeightMsgMultiSendKudos, &weightMsgMultiSendKudos, nil, func(_ *rand.Rand) {
+		weightMsgMultiSendKudos = DefaultWeightMsgMultiSendKudos
+	})
+	appParams.GetOrGenerate(OpWeightMsgRevokeKudos, &weightMsgRevokeKudos, nil, func(_ *rand.Rand) {
+		weightMsgRevokeKudos = DefaultWeightMsgRevokeKudos
+	})
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(weightMsgSendKudos, SimulateMsgSendKudos(txGen, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgMultiSendKudos, SimulateMsgMultiSendKudos(txGen, ak, bk, k)),
+		simulation.NewWeightedOperation(weightMsgRevokeKudos, SimulateMsgRevokeKudos(txGen, ak, bk, k)),
+	}
+}
+
+// SimulateMsgSendKudos generates a MsgSendKudos between two random accounts within the
+// sender's quota, optionally in a random active category and with a random tip
+func SimulateMsgSendKudos(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgSendKudos{})
+
+		from, _ := simtypes.RandomAcc(r, accs)
+		to, _ := simtypes.RandomAcc(r, accs)
+		if from.Address.Equals(to.Address) {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "sender and recipient are the same"), nil, nil
+		}
+
+		sendable := sendableKudos(ctx, k, from.Address.String())
+		if sendable == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "quota or allowance exhausted"), nil, nil
+		}
+
+		params := k.GetParams(ctx)
+		msg := &types.MsgSendKudos{
+			FromAddress: from.Address.String(),
+			ToAddress:   to.Address.String(),
+			Amount:      randAmount(r, sendable),
+			Comment:     randComment(r, params),
+			CategoryId:  randCategoryID(r, ctx, k),
+		}
+
+		// tip a random share of the sender's coins in the allowed denoms a quarter of the time
+		if r.Intn(4) == 0 {
+			var tippable sdk.Coins
+			for _, coin := range bk.SpendableCoins(ctx, from.Address) {
+				if params.IsTipDenomAllowed(coin.Denom) {
+					tippable = tippable.Add(coin)
+				}
+			}
+			msg.Tip = simtypes.RandSubsetCoins(r, tippable)
+		}
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Msg:             msg,
+			CoinsSpentInMsg: msg.Tip,
+			Context:         ctx,
+			SimAccount:      from,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgMultiSendKudos generates a MsgMultiSendKudos from a random account to up to
+// maxMultiSendOutputs other accounts, splitting what the sender may still send between them
+func SimulateMsgMultiSendKudos(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgMultiSendKudos{})
+
+		from, _ := simtypes.RandomAcc(r, accs)
+		sendable := sendableKudos(ctx, k, from.Address.String())
+
+		numOutputs := simtypes.RandIntBetween(r, 1, maxMultiSendOutputs+1)
+		if uint64(numOutputs) > sendable {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "quota or allowance exhausted"), nil, nil
+		}
+
+		params := k.GetParams(ctx)
+		outputs := make([]types.KudosOutput, 0, numOutputs)
+		for _, to := range r.Perm(len(accs)) {
+			if len(outputs) == numOutputs {
+				break
+			}
+			if accs[to].Address.Equals(from.Address) {
+				continue
+			}
+			outputs = append(outputs, types.KudosOutput{
+				ToAddress:  accs[to].Address.String(),
+				Amount:     randAmount(r, sendable/uint64(numOutputs)),
+				Comment:    randComment(r, params),
+				CategoryId: randCategoryID(r, ctx, k),
+			})
+		}
+		if len(outputs) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no recipients"), nil, nil
+		}
+
+		msg := &types.MsgMultiSendKudos{
+			FromAddress: from.Address.String(),
+			Outputs:     outputs,
+		}
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         txGen,
+			Msg:           msg,
+			Context:       ctx,
+			SimAccount:    from,
+			AccountKeeper: ak,
+			Bankkeeper:    bk,
+			ModuleName:    types.ModuleName,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// SimulateMsgRevokeKudos generates a MsgRevokeKudos for a random transfer that is still within
+// the revoke grace period and whose recipient still holds the amount, signed by its sender
+func SimulateMsgRevokeKudos(txGen client.TxConfig, ak types.AccountKeeper, bk types.BankKeeper, k keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgRevokeKudos{})
+
+		gracePeriod := int64(k.GetParams(ctx).RevokeGracePeriodSeconds)
+		now := ctx.BlockTime().Unix()
+
+		var revocable []types.KudosHistory
+		k.IterateKudosHistory(ctx, func(history types.KudosHistory) bool {
+			if !history.Revoked && !history.Legacy && history.Tip.Empty() && now < history.Timestamp+gracePeriod &&
+				k.GetKudosBalance(ctx, history.ToAddress) >= history.Amount {
+				revocable = append(revocable, history)
+			}
+			return false
+		})
+		if len(revocable) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no revocable transfers"), nil, nil
+		}
+
+		history := revocable[r.Intn(len(revocable))]
+		fromAddr, err := sdk.AccAddressFromBech32(history.FromAddress)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "invalid sender"), nil, err
+		}
+		from, found := simtypes.FindAccount(accs, fromAddr)
+		if !found {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "sender is not a simulation account"), nil, nil
+		}
+
+		msg := &types.MsgRevokeKudos{
+			FromAddress: history.FromAddress,
+			HistoryId:   history.Id,
+		}
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         txGen,
+			Msg:           msg,
+			Context:       ctx,
+			SimAccount:    from,
+			AccountKeeper: ak,
+			Bankkeeper:    bk,
+			ModuleName:    types.ModuleName,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// sendableKudos returns how many kudos an address may still send under its daily quota and,
+// in budget mode, its giveable allowance
+func sendableKudos(ctx sdk.Context, k keeper.Keeper, address string) uint64 {
+	sendable := k.GetDailyQuota(ctx, address).Remaining
+	if giveable := k.GetGiveable(ctx, address); giveable.BudgetEnabled && giveable.Remaining < sendable {
+		sendable = giveable.Remaining
+	}
+	return sendable
+}
+
+// randAmount returns a random amount between 1 and max, which must be positive
+func randAmount(r *rand.Rand, max uint64) uint64 {
+	return uint64(r.Int63n(int64(max))) + 1
+}
+
+// randComment returns a random comment the current params allow, empty a third of the time
+func randComment(r *rand.Rand, params types.Params) string {
+	if r.Intn(3) == 0 {
+		return ""
+	}
+	return simtypes.RandStringOfLength(r, simtypes.RandIntBetween(r, 1, int(params.MaxCommentLength)+1))
+}
+
+// randCategoryID returns a random active category, or 0 for none half of the time
+func randCategoryID(r *rand.Rand, ctx sdk.Context, k keeper.Keeper) uint64 {
+	if r.Intn(2) == 0 {
+		return 0
+	}
+
+	var active []uint64
+	k.IterateCategories(ctx, func(category types.Category) bool {
+		if category.Active {
+			active = append(active, category.Id)
+		}
+		return false
+	})
+	if len(active) == 0 {
+		return 0
+	}
+	return active[r.Intn(len(active))]
+}
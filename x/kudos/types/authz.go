@@ -0,0 +1,102 @@
+package types
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+)
+
+// gasCostPerAllowListEntry is charged for every allow list entry checked, as x/bank does for its
+// SendAuthorization, so that long allow lists are paid for by the grantee
+const gasCostPerAllowListEntry = uint64(10)
+
+var _ authz.Authorization = &SendKudosAuthorization{}
+
+// NewSendKudosAuthorization creates an authorization to send up to spendLimit kudos to the allowed
+// recipients (any recipient when empty) until expiration (unix seconds, 0 for none)
+func NewSendKudosAuthorization(spendLimit uint64, allowList []string, expiration int64) *SendKudosAuthorization {
+	return &SendKudosAuthorization{
+		SpendLimit: spendLimit,
+		AllowList:  allowList,
+		Expiration: expiration,
+	}
+}
+
+// MsgTypeURL implements authz.Authorization
+func (a SendKudosAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgSendKudos{})
+}
+
+// Accept implements authz.Authorization. It rejects expired authorizations, tipped transfers,
+// recipients outside the allow list and amounts above the remaining limit, and otherwise returns
+// the authorization with the amount deducted, or asks for its deletion once nothing is left.
+func (a SendKudosAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.AcceptResponse, error) {
+	msgSend, ok := msg.(*MsgSendKudos)
+	if !ok {
+		return authz.AcceptResponse{}, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "expected %s, got %T", a.MsgTypeURL(), msg)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if a.Expiration != 0 && sdkCtx.BlockTime().Unix() >= a.Expiration {
+		return authz.AcceptResponse{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "authorization expired at %d", a.Expiration)
+	}
+
+	if !msgSend.Tip.Empty() {
+		return authz.AcceptResponse{}, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "kudos with a tip cannot be sent on behalf of the granter")
+	}
+
+	if msgSend.Amount > a.SpendLimit {
+		return authz.AcceptResponse{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "requested %d kudos, the authorization allows %d more", msgSend.Amount, a.SpendLimit)
+	}
+
+	if len(a.AllowList) > 0 {
+		allowed := false
+		for _, addr := range a.AllowList {
+			sdkCtx.GasMeter().ConsumeGas(gasCostPerAllowListEntry, "send kudos authorization")
+			if addr == msgSend.ToAddress {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return authz.AcceptResponse{}, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "cannot send kudos to %s", msgSend.ToAddress)
+		}
+	}
+
+	left := a.SpendLimit - msgSend.Amount
+	if left == 0 {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{
+		Accept:  true,
+		Updated: NewSendKudosAuthorization(left, a.AllowList, a.Expiration),
+	}, nil
+}
+
+// ValidateBasic implements authz.Authorization
+func (a SendKudosAuthorization) ValidateBasic() error {
+	if a.SpendLimit == 0 {
+		return errorsmod.Wrap(ErrInvalidAmount, "spend limit must be greater than 0")
+	}
+
+	if a.Expiration < 0 {
+		return errorsmod.Wrapf(ErrInvalidRequest, "invalid expiration %d", a.Expiration)
+	}
+
+	seen := make(map[string]bool, len(a.AllowList))
+	for i, addr := range a.AllowList {
+		if _, err := sdk.AccAddressFromBech32(addr); err != nil {
+			return errorsmod.Wrapf(ErrInvalidAddress, "allow_list[%d]: %s", i, err)
+		}
+		if seen[addr] {
+			return errorsmod.Wrapf(ErrInvalidRequest, "allow_list[%d]: duplicate address %s", i, addr)
+		}
+		seen[addr] = true
+	}
+
+	return nil
+}
@@ -0,0 +1,86 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+func TestSendKudosAuthorization_Accept(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	ctx := sdk.NewContext(nil, cmtproto.Header{Time: now}, false, log.NewNopLogger())
+	send := func(to string, amount uint64) *types.MsgSendKudos {
+		return &types.MsgSendKudos{FromAddress: fromAddr, ToAddress: to, Amount: amount}
+	}
+
+	auth := types.NewSendKudosAuthorization(10, nil, 0)
+	require.Equal(t, "/kudos.MsgSendKudos", auth.MsgTypeURL())
+
+	// The remaining limit is carried over to the updated authorization
+	resp, err := auth.Accept(ctx, send(toAddr, 4))
+	require.NoError(t, err)
+	require.Equal(t, authz.AcceptResponse{Accept: true, Updated: types.NewSendKudosAuthorization(6, nil, 0)}, resp)
+
+	// Spending the whole limit removes the grant
+	resp, err = auth.Accept(ctx, send(toAddr, 10))
+	require.NoError(t, err)
+	require.Equal(t, authz.AcceptResponse{Accept: true, Delete: true}, resp)
+
+	_, err = auth.Accept(ctx, send(toAddr, 11))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	tipped := send(toAddr, 1)
+	tipped.Tip = sdk.NewCoins(sdk.NewInt64Coin("stake", 1))
+	_, err = auth.Accept(ctx, tipped)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = auth.Accept(ctx, &types.MsgRevokeKudos{FromAddress: fromAddr, HistoryId: 1})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidType)
+
+	// Only listed recipients are accepted
+	listed := types.NewSendKudosAuthorization(10, []string{toAddr}, 0)
+	_, err = listed.Accept(ctx, send(toAddr, 1))
+	require.NoError(t, err)
+	_, err = listed.Accept(ctx, send(sameAddr, 1))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// The authorization is rejected from its expiration on
+	expiring := types.NewSendKudosAuthorization(10, nil, now.Unix()+1)
+	_, err = expiring.Accept(ctx, send(toAddr, 1))
+	require.NoError(t, err)
+	_, err = expiring.Accept(ctx.WithBlockTime(now.Add(time.Second)), send(toAddr, 1))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+}
+
+func TestSendKudosAuthorization_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name      string
+		auth      *types.SendKudosAuthorization
+		expectErr error
+	}{
+		{name: "valid", auth: types.NewSendKudosAuthorization(10, []string{toAddr, sameAddr}, 1700000000)},
+		{name: "zero spend limit", auth: types.NewSendKudosAuthorization(0, nil, 0), expectErr: types.ErrInvalidAmount},
+		{name: "negative expiration", auth: types.NewSendKudosAuthorization(10, nil, -1), expectErr: types.ErrInvalidRequest},
+		{name: "invalid recipient", auth: types.NewSendKudosAuthorization(10, []string{"invalid"}, 0), expectErr: types.ErrInvalidAddress},
+		{name: "duplicate recipient", auth: types.NewSendKudosAuthorization(10, []string{toAddr, toAddr}, 0), expectErr: types.ErrInvalidRequest},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.auth.ValidateBasic()
+			if tc.expectErr != nil {
+				require.ErrorIs(t, err, tc.expectErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	// MaxCategoryNameLength bounds the length of a category name
+	MaxCategoryNameLength = 64
+
+	// MaxCategoryDescriptionLength bounds the length of a category description
+	MaxCategoryDescriptionLength = 256
+)
+
+// NewCategory creates a new Category instance
+func NewCategory(id uint64, name, description string, active bool) Category {
+	return Category{
+		Id:          id,
+		Name:        name,
+		Description: description,
+		Active:      active,
+	}
+}
+
+// Validate performs basic validation of a category
+func (c Category) Validate() error {
+	if c.Id == 0 {
+		return errorsmod.Wrap(ErrInvalidCategory, "id must be greater than 0")
+	}
+	if strings.TrimSpace(c.Name) == "" {
+		return errorsmod.Wrapf(ErrInvalidCategory, "category %d: name cannot be empty", c.Id)
+	}
+	if len(c.Name) > MaxCategoryNameLength {
+		return errorsmod.Wrapf(ErrInvalidCategory, "category %d: name length %d exceeds %d", c.Id, len(c.Name), MaxCategoryNameLength)
+	}
+	if len(c.Description) > MaxCategoryDescriptionLength {
+		return errorsmod.Wrapf(ErrInvalidCategory, "category %d: description length %d exceeds %d", c.Id, len(c.Description), MaxCategoryDescriptionLength)
+	}
+
+	return nil
+}
@@ -5,17 +5,37 @@ import (
 	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
 // RegisterCodec registers concrete types on codec
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSendKudos{}, "kudos/SendKudos", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "kudos/UpdateParams", nil)
+	cdc.RegisterConcrete(&MsgMultiSendKudos{}, "kudos/MultiSendKudos", nil)
+	cdc.RegisterConcrete(&MsgSetCategory{}, "kudos/SetCategory", nil)
+	cdc.RegisterConcrete(&MsgRevokeKudos{}, "kudos/RevokeKudos", nil)
+	cdc.RegisterConcrete(&MsgSetBudgetAllowance{}, "kudos/SetBudgetAllowance", nil)
+	cdc.RegisterConcrete(&MsgFundRewardPool{}, "kudos/FundRewardPool", nil)
+	cdc.RegisterConcrete(&MsgRedeemKudos{}, "kudos/RedeemKudos", nil)
+	cdc.RegisterConcrete(&SendKudosAuthorization{}, "kudos/SendKudosAuthorization", nil)
 }
 
 // RegisterInterfaces registers the kudos interfaces to the interface registry
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgSendKudos{},
+		&MsgUpdateParams{},
+		&MsgMultiSendKudos{},
+		&MsgSetCategory{},
+		&MsgRevokeKudos{},
+		&MsgSetBudgetAllowance{},
+		&MsgFundRewardPool{},
+		&MsgRedeemKudos{},
+	)
+
+	registry.RegisterImplementations((*authz.Authorization)(nil),
+		&SendKudosAuthorization{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -9,7 +9,25 @@ var (
 	ErrInvalidAddress     = errors.Register(ModuleName, 1, "invalid address")
 	ErrSameAddress        = errors.Register(ModuleName, 2, "cannot send kudos to yourself")
 	ErrInvalidAmount      = errors.Register(ModuleName, 3, "amount must be greater than 0")
-	ErrCommentTooLong     = errors.Register(ModuleName, 4, "comment exceeds 140 characters")
+	ErrCommentTooLong     = errors.Register(ModuleName, 4, "comment too long")
 	ErrInvalidLeaderboard = errors.Register(ModuleName, 5, "invalid leaderboard parameters")
 	ErrDailyLimitExceeded = errors.Register(ModuleName, 6, "daily kudos limit exceeded")
+	ErrInvalidGenesis     = errors.Register(ModuleName, 7, "invalid genesis state")
+	ErrInvalidSigner      = errors.Register(ModuleName, 8, "expected authority account as only signer")
+	ErrInvalidParams      = errors.Register(ModuleName, 9, "invalid params")
+	ErrInvalidRequest     = errors.Register(ModuleName, 10, "invalid request")
+	ErrHistoryNotFound    = errors.Register(ModuleName, 11, "kudos history entry not found")
+	ErrInvalidCategory    = errors.Register(ModuleName, 12, "invalid category")
+	ErrNotSender          = errors.Register(ModuleName, 13, "only the original sender can revoke kudos")
+	ErrAlreadyRevoked     = errors.Register(ModuleName, 14, "kudos already revoked")
+	ErrRevokeExpired      = errors.Register(ModuleName, 15, "revoke grace period expired")
+	ErrSeasonNotFound     = errors.Register(ModuleName, 16, "season not found")
+	ErrBudgetExceeded     = errors.Register(ModuleName, 17, "giveable kudos budget exceeded")
+	ErrInvalidTip         = errors.Register(ModuleName, 18, "invalid tip")
+	ErrRedeemDisabled     = errors.Register(ModuleName, 19, "kudos redemption is disabled")
+	ErrInsufficientKudos  = errors.Register(ModuleName, 20, "insufficient kudos balance")
+	ErrRedeemCapExceeded  = errors.Register(ModuleName, 21, "kudos redemption cap exceeded")
+	ErrRewardPoolDepleted = errors.Register(ModuleName, 22, "reward pool has insufficient funds")
+	ErrInvariantBroken    = errors.Register(ModuleName, 23, "kudos invariant broken")
+	ErrSeasonRollover     = errors.Register(ModuleName, 24, "season rollover in progress")
 )
@@ -0,0 +1,44 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BankKeeper defines the expected bank keeper used to transfer kudos tips and to hold and pay out
+// the reward pool in the kudos module account; simulations also use it to pay random fees
+type BankKeeper interface {
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	BlockedAddr(addr sdk.AccAddress) bool
+	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// AccountKeeper defines the expected account keeper used to check that the kudos module account,
+// which holds the reward pool, is registered, and by simulations to sign transactions
+type AccountKeeper interface {
+	GetModuleAddress(moduleName string) sdk.AccAddress
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+}
+
+// KudosHooks lets other modules react to kudos transfers. Hooks are called within the message
+// that triggered them, so an error from any hook aborts the whole message.
+type KudosHooks interface {
+	// BeforeKudosSent is called once a transfer has passed validation and before any state is
+	// written; returning an error vetoes the transfer
+	BeforeKudosSent(ctx context.Context, fromAddress, toAddress string, amount, categoryID uint64) error
+	// AfterKudosSent is called after a transfer has been applied and recorded in history
+	AfterKudosSent(ctx context.Context, history KudosHistory) error
+	// AfterKudosRevoked is called after a transfer has been revoked by its sender
+	AfterKudosRevoked(ctx context.Context, history KudosHistory) error
+}
+
+// KudosHooksWrapper is a wrapper for modules to inject KudosHooks using depinject
+type KudosHooksWrapper struct{ KudosHooks }
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface
+func (KudosHooksWrapper) IsOnePerModuleType() {}
@@ -1,16 +1,394 @@
 package types
 
-// GenesisState defines the kudos module's genesis state.
-type GenesisState struct {
-	// Add genesis fields if needed in the future
-}
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 // NewGenesisState creates a new GenesisState object
-func NewGenesisState() *GenesisState {
-	return &GenesisState{}
+func NewGenesisState(params Params, balances []KudosBalance, history []KudosHistory, historyCounter uint64, dailyQuotas []DailyQuota, sentTotals []KudosSentTotal, periodBuckets []PeriodBucket, categories []Category, categoryBalances []CategoryBalance, lifetimeTotals []KudosLifetimeTotal, decayState DecayState, currentSeason Season, seasons []Season, seasonStandings []SeasonStanding, budgetAllowances []BudgetAllowance, giveableBalances []GiveableBalance, redemptions []Redemption, redemptionCounter uint64, redeemUsages []RedeemUsage, seasonRollover SeasonRolloverState) *GenesisState {
+	return &GenesisState{
+		Params:            params,
+		Balances:          balances,
+		History:           history,
+		HistoryCounter:    historyCounter,
+		DailyQuotas:       dailyQuotas,
+		SentTotals:        sentTotals,
+		PeriodBuckets:     periodBuckets,
+		Categories:        categories,
+		CategoryBalances:  categoryBalances,
+		LifetimeTotals:    lifetimeTotals,
+		DecayState:        decayState,
+		CurrentSeason:     currentSeason,
+		Seasons:           seasons,
+		SeasonStandings:   seasonStandings,
+		BudgetAllowances:  budgetAllowances,
+		GiveableBalances:  giveableBalances,
+		Redemptions:       redemptions,
+		RedemptionCounter: redemptionCounter,
+		RedeemUsages:      redeemUsages,
+		SeasonRollover:    seasonRollover,
+	}
 }
 
 // DefaultGenesisState returns a default genesis state
 func DefaultGenesisState() *GenesisState {
-	return NewGenesisState()
+	return NewGenesisState(DefaultParams(), []KudosBalance{}, []KudosHistory{}, 0, []DailyQuota{}, []KudosSentTotal{}, []PeriodBucket{}, []Category{}, []CategoryBalance{}, []KudosLifetimeTotal{}, DecayState{}, Season{}, []Season{}, []SeasonStanding{}, []BudgetAllowance{}, []GiveableBalance{}, []Redemption{}, 0, []RedeemUsage{}, SeasonRolloverState{})
+}
+
+// Validate performs basic genesis state validation returning an error upon any failure
+func (gs GenesisState) Validate() error {
+	if err := gs.Params.Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidGenesis, "params: %s", err)
+	}
+
+	seenBalances := make(map[string]bool, len(gs.Balances))
+	for i, balance := range gs.Balances {
+		if _, err := sdk.AccAddressFromBech32(balance.Address); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "balances[%d]: invalid address %q: %s", i, balance.Address, err)
+		}
+		if seenBalances[balance.Address] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "balances[%d]: duplicate balance for address %s", i, balance.Address)
+		}
+		seenBalances[balance.Address] = true
+	}
+
+	seenLifetimeTotals := make(map[string]bool, len(gs.LifetimeTotals))
+	lifetimeTotals := make(map[string]uint64, len(gs.LifetimeTotals))
+	for i, lifetimeTotal := range gs.LifetimeTotals {
+		if _, err := sdk.AccAddressFromBech32(lifetimeTotal.Address); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "lifetime_totals[%d]: invalid address %q: %s", i, lifetimeTotal.Address, err)
+		}
+		if seenLifetimeTotals[lifetimeTotal.Address] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "lifetime_totals[%d]: duplicate lifetime total for address %s", i, lifetimeTotal.Address)
+		}
+		seenLifetimeTotals[lifetimeTotal.Address] = true
+		lifetimeTotals[lifetimeTotal.Address] = lifetimeTotal.Total
+	}
+
+	// Decay only ever lowers a balance below what the address received
+	for i, balance := range gs.Balances {
+		if balance.Balance > lifetimeTotals[balance.Address] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "balances[%d]: address %s has balance %d above its lifetime total %d", i, balance.Address, balance.Balance, lifetimeTotals[balance.Address])
+		}
+	}
+
+	if gs.DecayState.NextAddress != "" {
+		if !gs.DecayState.InProgress {
+			return errorsmod.Wrap(ErrInvalidGenesis, "decay_state: next address set without a pass in progress")
+		}
+		if _, err := sdk.AccAddressFromBech32(gs.DecayState.NextAddress); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "decay_state: invalid next address %q: %s", gs.DecayState.NextAddress, err)
+		}
+	}
+
+	seasons := make(map[uint64]bool, len(gs.Seasons))
+	for i, season := range gs.Seasons {
+		if season.Id == 0 {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "seasons[%d]: id must be greater than 0", i)
+		}
+		if season.EndTime <= season.StartTime {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "seasons[%d]: end time %d is not after start time %d", i, season.EndTime, season.StartTime)
+		}
+		if seasons[season.Id] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "seasons[%d]: duplicate season id %d", i, season.Id)
+		}
+		if gs.CurrentSeason.Id != 0 && season.Id >= gs.CurrentSeason.Id {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "seasons[%d]: ended season %d is not before the current season %d", i, season.Id, gs.CurrentSeason.Id)
+		}
+		seasons[season.Id] = true
+	}
+	if gs.CurrentSeason.Id != 0 && gs.CurrentSeason.EndTime <= gs.CurrentSeason.StartTime {
+		return errorsmod.Wrapf(ErrInvalidGenesis, "current_season: end time %d is not after start time %d", gs.CurrentSeason.EndTime, gs.CurrentSeason.StartTime)
+	}
+
+	type standingID struct {
+		seasonID uint64
+		rank     uint64
+	}
+	seenStandings := make(map[standingID]bool, len(gs.SeasonStandings))
+	for i, standing := range gs.SeasonStandings {
+		if !seasons[standing.SeasonId] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "season_standings[%d]: unknown ended season %d", i, standing.SeasonId)
+		}
+		if standing.Rank == 0 {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "season_standings[%d]: rank must be greater than 0", i)
+		}
+		if _, err := sdk.AccAddressFromBech32(standing.Address); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "season_standings[%d]: invalid address %q: %s", i, standing.Address, err)
+		}
+		id := standingID{seasonID: standing.SeasonId, rank: standing.Rank}
+		if seenStandings[id] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "season_standings[%d]: duplicate rank %d in season %d", i, standing.Rank, standing.SeasonId)
+		}
+		seenStandings[id] = true
+	}
+
+	if gs.SeasonRollover.SeasonId == 0 {
+		if gs.SeasonRollover.Archived != 0 {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "season_rollover: %d archived standings without a season", gs.SeasonRollover.Archived)
+		}
+	} else {
+		if !seasons[gs.SeasonRollover.SeasonId] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "season_rollover: unknown ended season %d", gs.SeasonRollover.SeasonId)
+		}
+		if gs.CurrentSeason.Id != 0 {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "season_rollover: season %d is still being archived while season %d runs", gs.SeasonRollover.SeasonId, gs.CurrentSeason.Id)
+		}
+		var archived uint64
+		for _, standing := range gs.SeasonStandings {
+			if standing.SeasonId == gs.SeasonRollover.SeasonId {
+				archived++
+			}
+		}
+		if archived != gs.SeasonRollover.Archived {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "season_rollover: season %d has %d standings, expected %d", gs.SeasonRollover.SeasonId, archived, gs.SeasonRollover.Archived)
+		}
+	}
+
+	seenAllowances := make(map[string]bool, len(gs.BudgetAllowances))
+	for i, allowance := range gs.BudgetAllowances {
+		if _, err := sdk.AccAddressFromBech32(allowance.Address); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "budget_allowances[%d]: invalid address %q: %s", i, allowance.Address, err)
+		}
+		if seenAllowances[allowance.Address] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "budget_allowances[%d]: duplicate allowance for address %s", i, allowance.Address)
+		}
+		seenAllowances[allowance.Address] = true
+	}
+
+	seenGiveable := make(map[string]bool, len(gs.GiveableBalances))
+	for i, balance := range gs.GiveableBalances {
+		if _, err := sdk.AccAddressFromBech32(balance.Address); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "giveable_balances[%d]: invalid address %q: %s", i, balance.Address, err)
+		}
+		if seenGiveable[balance.Address] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "giveable_balances[%d]: duplicate giveable balance for address %s", i, balance.Address)
+		}
+		seenGiveable[balance.Address] = true
+	}
+
+	var maxRedemptionID uint64
+	seenRedemptions := make(map[uint64]bool, len(gs.Redemptions))
+	for i, redemption := range gs.Redemptions {
+		if redemption.Id == 0 {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "redemptions[%d]: id must be greater than 0", i)
+		}
+		if seenRedemptions[redemption.Id] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "redemptions[%d]: duplicate id %d", i, redemption.Id)
+		}
+		seenRedemptions[redemption.Id] = true
+		if _, err := sdk.AccAddressFromBech32(redemption.Address); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "redemptions[%d] (id %d): invalid address %q: %s", i, redemption.Id, redemption.Address, err)
+		}
+		if redemption.Amount == 0 {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "redemptions[%d] (id %d): amount must be greater than 0", i, redemption.Id)
+		}
+		if !redemption.Payout.IsValid() || !redemption.Payout.IsPositive() {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "redemptions[%d] (id %d): invalid payout %s", i, redemption.Id, redemption.Payout)
+		}
+		maxRedemptionID = max(maxRedemptionID, redemption.Id)
+	}
+
+	if gs.RedemptionCounter < maxRedemptionID {
+		return errorsmod.Wrapf(ErrInvalidGenesis, "redemption counter %d is lower than the highest redemption id %d", gs.RedemptionCounter, maxRedemptionID)
+	}
+
+	seenRedeemUsages := make(map[string]bool, len(gs.RedeemUsages))
+	for i, usage := range gs.RedeemUsages {
+		if _, err := sdk.AccAddressFromBech32(usage.Address); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "redeem_usages[%d]: invalid address %q: %s", i, usage.Address, err)
+		}
+		if seenRedeemUsages[usage.Address] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "redeem_usages[%d]: duplicate redeem usage for address %s", i, usage.Address)
+		}
+		seenRedeemUsages[usage.Address] = true
+	}
+
+	seenSentTotals := make(map[string]bool, len(gs.SentTotals))
+	for i, sentTotal := range gs.SentTotals {
+		if _, err := sdk.AccAddressFromBech32(sentTotal.Address); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "sent_totals[%d]: invalid address %q: %s", i, sentTotal.Address, err)
+		}
+		if seenSentTotals[sentTotal.Address] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "sent_totals[%d]: duplicate sent total for address %s", i, sentTotal.Address)
+		}
+		seenSentTotals[sentTotal.Address] = true
+	}
+
+	type bucketID struct {
+		day     uint64
+		address string
+	}
+	seenBuckets := make(map[bucketID]bool, len(gs.PeriodBuckets))
+	for i, bucket := range gs.PeriodBuckets {
+		if _, err := sdk.AccAddressFromBech32(bucket.Address); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "period_buckets[%d]: invalid address %q: %s", i, bucket.Address, err)
+		}
+		if bucket.Amount == 0 {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "period_buckets[%d]: amount must be greater than 0", i)
+		}
+		id := bucketID{day: bucket.Day, address: bucket.Address}
+		if seenBuckets[id] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "period_buckets[%d]: duplicate bucket for address %s on day %d", i, bucket.Address, bucket.Day)
+		}
+		seenBuckets[id] = true
+	}
+
+	categories := make(map[uint64]bool, len(gs.Categories))
+	for i, category := range gs.Categories {
+		if err := category.Validate(); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "categories[%d]: %s", i, err)
+		}
+		if categories[category.Id] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "categories[%d]: duplicate category id %d", i, category.Id)
+		}
+		categories[category.Id] = true
+	}
+
+	type categoryBalanceID struct {
+		categoryID uint64
+		address    string
+	}
+	seenCategoryBalances := make(map[categoryBalanceID]bool, len(gs.CategoryBalances))
+	for i, balance := range gs.CategoryBalances {
+		if _, err := sdk.AccAddressFromBech32(balance.Address); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "category_balances[%d]: invalid address %q: %s", i, balance.Address, err)
+		}
+		if !categories[balance.CategoryId] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "category_balances[%d]: unknown category %d", i, balance.CategoryId)
+		}
+		id := categoryBalanceID{categoryID: balance.CategoryId, address: balance.Address}
+		if seenCategoryBalances[id] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "category_balances[%d]: duplicate balance for address %s in category %d", i, balance.Address, balance.CategoryId)
+		}
+		seenCategoryBalances[id] = true
+	}
+
+	var maxHistoryID uint64
+	received := make(map[string]uint64)
+	sent := make(map[string]uint64)
+	receivedByCategory := make(map[categoryBalanceID]uint64)
+	for i, history := range gs.History {
+		if history.Id == 0 {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "history[%d]: id must be greater than 0", i)
+		}
+		if i > 0 {
+			prevID := gs.History[i-1].Id
+			if history.Id == prevID {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "history[%d]: duplicate id %d", i, history.Id)
+			}
+			if history.Id != prevID+1 {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "history[%d]: id %d does not follow previous id %d", i, history.Id, prevID)
+			}
+		}
+		if history.Amount == 0 {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "history[%d] (id %d): amount must be greater than 0", i, history.Id)
+		}
+		if _, err := sdk.AccAddressFromBech32(history.FromAddress); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "history[%d] (id %d): invalid from address %q: %s", i, history.Id, history.FromAddress, err)
+		}
+		if _, err := sdk.AccAddressFromBech32(history.ToAddress); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "history[%d] (id %d): invalid to address %q: %s", i, history.Id, history.ToAddress, err)
+		}
+
+		if history.CategoryId != 0 && !categories[history.CategoryId] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "history[%d] (id %d): unknown category %d", i, history.Id, history.CategoryId)
+		}
+		if !history.Tip.IsValid() {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "history[%d] (id %d): invalid tip %s", i, history.Id, history.Tip)
+		}
+		if !history.Revoked && history.RevokedAt != 0 {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "history[%d] (id %d): revoked_at set on an entry that is not revoked", i, history.Id)
+		}
+
+		maxHistoryID = history.Id
+
+		// Revoked transfers no longer count towards any balance or total
+		if history.Revoked {
+			continue
+		}
+		if history.CategoryId != 0 {
+			receivedByCategory[categoryBalanceID{categoryID: history.CategoryId, address: history.ToAddress}] += history.Amount
+		}
+		received[history.ToAddress] += history.Amount
+		sent[history.FromAddress] += history.Amount
+	}
+
+	// The counter must point at the newest entry, or the next transfer would overwrite one. Without
+	// any history it may be anything, since genesis may have pruned all of it.
+	if len(gs.History) > 0 && gs.HistoryCounter != maxHistoryID {
+		return errorsmod.Wrapf(ErrInvalidGenesis, "history counter %d does not match the highest history id %d", gs.HistoryCounter, maxHistoryID)
+	}
+
+	seenQuotas := make(map[string]bool, len(gs.DailyQuotas))
+	for i, quota := range gs.DailyQuotas {
+		if _, err := sdk.AccAddressFromBech32(quota.Address); err != nil {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "daily_quotas[%d]: invalid address %q: %s", i, quota.Address, err)
+		}
+		if seenQuotas[quota.Address] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "daily_quotas[%d]: duplicate quota for address %s", i, quota.Address)
+		}
+		seenQuotas[quota.Address] = true
+	}
+
+	// Balances and sent totals can only be cross-checked when history starts at the first entry and
+	// runs up to the counter, i.e. nothing has been pruned.
+	historyComplete := (len(gs.History) == 0 && gs.HistoryCounter == 0) ||
+		(len(gs.History) > 0 && gs.History[0].Id == 1 && maxHistoryID == gs.HistoryCounter)
+	if !historyComplete {
+		return nil
+	}
+
+	for i, lifetimeTotal := range gs.LifetimeTotals {
+		if lifetimeTotal.Total != received[lifetimeTotal.Address] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "lifetime_totals[%d]: address %s has total %d but received %d in history", i, lifetimeTotal.Address, lifetimeTotal.Total, received[lifetimeTotal.Address])
+		}
+	}
+	for _, history := range gs.History {
+		if !seenLifetimeTotals[history.ToAddress] && received[history.ToAddress] > 0 {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "history id %d: recipient %s has no lifetime total entry but received %d in history", history.Id, history.ToAddress, received[history.ToAddress])
+		}
+	}
+	// Decay, a season reset or a redemption lowers balances below what was received; until one of
+	// them has happened, every balance must equal what the address received
+	balancesLowered := gs.DecayState.Epoch != 0 || len(gs.Seasons) != 0 || gs.RedemptionCounter != 0
+	if !balancesLowered {
+		for i, balance := range gs.Balances {
+			if balance.Balance != received[balance.Address] {
+				return errorsmod.Wrapf(ErrInvalidGenesis, "balances[%d]: address %s has balance %d but received %d in history", i, balance.Address, balance.Balance, received[balance.Address])
+			}
+		}
+	}
+	for _, history := range gs.History {
+		if !seenBalances[history.ToAddress] && received[history.ToAddress] > 0 {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "history id %d: recipient %s has no balance entry but received %d in history", history.Id, history.ToAddress, received[history.ToAddress])
+		}
+	}
+
+	for i, sentTotal := range gs.SentTotals {
+		if sentTotal.Total != sent[sentTotal.Address] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "sent_totals[%d]: address %s has total %d but sent %d in history", i, sentTotal.Address, sentTotal.Total, sent[sentTotal.Address])
+		}
+	}
+	for _, history := range gs.History {
+		if !seenSentTotals[history.FromAddress] && !history.Revoked {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "history id %d: sender %s has no sent total entry but sent %d in history", history.Id, history.FromAddress, sent[history.FromAddress])
+		}
+	}
+
+	for i, balance := range gs.CategoryBalances {
+		received := receivedByCategory[categoryBalanceID{categoryID: balance.CategoryId, address: balance.Address}]
+		if balance.Balance != received {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "category_balances[%d]: address %s has balance %d in category %d but received %d in history", i, balance.Address, balance.Balance, balance.CategoryId, received)
+		}
+	}
+	for _, history := range gs.History {
+		id := categoryBalanceID{categoryID: history.CategoryId, address: history.ToAddress}
+		if history.CategoryId != 0 && !history.Revoked && !seenCategoryBalances[id] {
+			return errorsmod.Wrapf(ErrInvalidGenesis, "history id %d: recipient %s has no balance entry in category %d but received %d in history", history.Id, history.ToAddress, history.CategoryId, receivedByCategory[id])
+		}
+	}
+
+	return nil
 }
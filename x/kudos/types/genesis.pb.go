@@ -0,0 +1,3026 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: kudos/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the kudos module's genesis state.
+type GenesisState struct {
+	Balances         []KudosBalance       `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances"`
+	History          []KudosHistory       `protobuf:"bytes,2,rep,name=history,proto3" json:"history"`
+	HistoryCounter   uint64               `protobuf:"varint,3,opt,name=history_counter,json=historyCounter,proto3" json:"history_counter,omitempty" yaml:"history_counter"`
+	DailyQuotas      []DailyQuota         `protobuf:"bytes,4,rep,name=daily_quotas,json=dailyQuotas,proto3" json:"daily_quotas" yaml:"daily_quotas"`
+	Params           Params               `protobuf:"bytes,5,opt,name=params,proto3" json:"params"`
+	SentTotals       []KudosSentTotal     `protobuf:"bytes,6,rep,name=sent_totals,json=sentTotals,proto3" json:"sent_totals" yaml:"sent_totals"`
+	PeriodBuckets    []PeriodBucket       `protobuf:"bytes,7,rep,name=period_buckets,json=periodBuckets,proto3" json:"period_buckets" yaml:"period_buckets"`
+	Categories       []Category           `protobuf:"bytes,8,rep,name=categories,proto3" json:"categories"`
+	CategoryBalances []CategoryBalance    `protobuf:"bytes,9,rep,name=category_balances,json=categoryBalances,proto3" json:"category_balances" yaml:"category_balances"`
+	LifetimeTotals   []KudosLifetimeTotal `protobuf:"bytes,10,rep,name=lifetime_totals,json=lifetimeTotals,proto3" json:"lifetime_totals" yaml:"lifetime_totals"`
+	DecayState       DecayState           `protobuf:"bytes,11,opt,name=decay_state,json=decayState,proto3" json:"decay_state" yaml:"decay_state"`
+	// current_season is the running season; its id is 0 while seasons have never started
+	CurrentSeason     Season            `protobuf:"bytes,12,opt,name=current_season,json=currentSeason,proto3" json:"current_season" yaml:"current_season"`
+	Seasons           []Season          `protobuf:"bytes,13,rep,name=seasons,proto3" json:"seasons"`
+	SeasonStandings   []SeasonStanding  `protobuf:"bytes,14,rep,name=season_standings,json=seasonStandings,proto3" json:"season_standings" yaml:"season_standings"`
+	BudgetAllowances  []BudgetAllowance `protobuf:"bytes,15,rep,name=budget_allowances,json=budgetAllowances,proto3" json:"budget_allowances" yaml:"budget_allowances"`
+	GiveableBalances  []GiveableBalance `protobuf:"bytes,16,rep,name=giveable_balances,json=giveableBalances,proto3" json:"giveable_balances" yaml:"giveable_balances"`
+	Redemptions       []Redemption      `protobuf:"bytes,17,rep,name=redemptions,proto3" json:"redemptions"`
+	RedemptionCounter uint64            `protobuf:"varint,18,opt,name=redemption_counter,json=redemptionCounter,proto3" json:"redemption_counter,omitempty" yaml:"redemption_counter"`
+	RedeemUsages      []RedeemUsage     `protobuf:"bytes,19,rep,name=redeem_usages,json=redeemUsages,proto3" json:"redeem_usages" yaml:"redeem_usages"`
+	// season_rollover is the archival of an ended season still being run by the BeginBlocker
+	SeasonRollover SeasonRolloverState `protobuf:"bytes,20,opt,name=season_rollover,json=seasonRollover,proto3" json:"season_rollover" yaml:"season_rollover"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ea50ed9b2975d1, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetBalances() []KudosBalance {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHistory() []KudosHistory {
+	if m != nil {
+		return m.History
+	}
+	return nil
+}
+
+func (m *GenesisState) GetHistoryCounter() uint64 {
+	if m != nil {
+		return m.HistoryCounter
+	}
+	return 0
+}
+
+func (m *GenesisState) GetDailyQuotas() []DailyQuota {
+	if m != nil {
+		return m.DailyQuotas
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetSentTotals() []KudosSentTotal {
+	if m != nil {
+		return m.SentTotals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPeriodBuckets() []PeriodBucket {
+	if m != nil {
+		return m.PeriodBuckets
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCategories() []Category {
+	if m != nil {
+		return m.Categories
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCategoryBalances() []CategoryBalance {
+	if m != nil {
+		return m.CategoryBalances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLifetimeTotals() []KudosLifetimeTotal {
+	if m != nil {
+		return m.LifetimeTotals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDecayState() DecayState {
+	if m != nil {
+		return m.DecayState
+	}
+	return DecayState{}
+}
+
+func (m *GenesisState) GetCurrentSeason() Season {
+	if m != nil {
+		return m.CurrentSeason
+	}
+	return Season{}
+}
+
+func (m *GenesisState) GetSeasons() []Season {
+	if m != nil {
+		return m.Seasons
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSeasonStandings() []SeasonStanding {
+	if m != nil {
+		return m.SeasonStandings
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBudgetAllowances() []BudgetAllowance {
+	if m != nil {
+		return m.BudgetAllowances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetGiveableBalances() []GiveableBalance {
+	if m != nil {
+		return m.GiveableBalances
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRedemptions() []Redemption {
+	if m != nil {
+		return m.Redemptions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRedemptionCounter() uint64 {
+	if m != nil {
+		return m.RedemptionCounter
+	}
+	return 0
+}
+
+func (m *GenesisState) GetRedeemUsages() []RedeemUsage {
+	if m != nil {
+		return m.RedeemUsages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSeasonRollover() SeasonRolloverState {
+	if m != nil {
+		return m.SeasonRollover
+	}
+	return SeasonRolloverState{}
+}
+
+// KudosBalance is the received kudos balance of a single address
+type KudosBalance struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Balance uint64 `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`
+}
+
+func (m *KudosBalance) Reset()         { *m = KudosBalance{} }
+func (m *KudosBalance) String() string { return proto.CompactTextString(m) }
+func (*KudosBalance) ProtoMessage()    {}
+func (*KudosBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ea50ed9b2975d1, []int{1}
+}
+func (m *KudosBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KudosBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KudosBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KudosBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KudosBalance.Merge(m, src)
+}
+func (m *KudosBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *KudosBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_KudosBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KudosBalance proto.InternalMessageInfo
+
+func (m *KudosBalance) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *KudosBalance) GetBalance() uint64 {
+	if m != nil {
+		return m.Balance
+	}
+	return 0
+}
+
+// KudosSentTotal is the lifetime total of kudos sent by a single address
+type KudosSentTotal struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Total   uint64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (m *KudosSentTotal) Reset()         { *m = KudosSentTotal{} }
+func (m *KudosSentTotal) String() string { return proto.CompactTextString(m) }
+func (*KudosSentTotal) ProtoMessage()    {}
+func (*KudosSentTotal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ea50ed9b2975d1, []int{2}
+}
+func (m *KudosSentTotal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KudosSentTotal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KudosSentTotal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KudosSentTotal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KudosSentTotal.Merge(m, src)
+}
+func (m *KudosSentTotal) XXX_Size() int {
+	return m.Size()
+}
+func (m *KudosSentTotal) XXX_DiscardUnknown() {
+	xxx_messageInfo_KudosSentTotal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KudosSentTotal proto.InternalMessageInfo
+
+func (m *KudosSentTotal) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *KudosSentTotal) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+// KudosLifetimeTotal is the lifetime total of kudos received by a single address, unaffected by decay
+type KudosLifetimeTotal struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Total   uint64 `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+}
+
+func (m *KudosLifetimeTotal) Reset()         { *m = KudosLifetimeTotal{} }
+func (m *KudosLifetimeTotal) String() string { return proto.CompactTextString(m) }
+func (*KudosLifetimeTotal) ProtoMessage()    {}
+func (*KudosLifetimeTotal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ea50ed9b2975d1, []int{3}
+}
+func (m *KudosLifetimeTotal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KudosLifetimeTotal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KudosLifetimeTotal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KudosLifetimeTotal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KudosLifetimeTotal.Merge(m, src)
+}
+func (m *KudosLifetimeTotal) XXX_Size() int {
+	return m.Size()
+}
+func (m *KudosLifetimeTotal) XXX_DiscardUnknown() {
+	xxx_messageInfo_KudosLifetimeTotal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_KudosLifetimeTotal proto.InternalMessageInfo
+
+func (m *KudosLifetimeTotal) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *KudosLifetimeTotal) GetTotal() uint64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+// BudgetAllowance is the per-epoch giveable allowance override of a single address in budget mode
+type BudgetAllowance struct {
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Allowance uint64 `protobuf:"varint,2,opt,name=allowance,proto3" json:"allowance,omitempty"`
+}
+
+func (m *BudgetAllowance) Reset()         { *m = BudgetAllowance{} }
+func (m *BudgetAllowance) String() string { return proto.CompactTextString(m) }
+func (*BudgetAllowance) ProtoMessage()    {}
+func (*BudgetAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ea50ed9b2975d1, []int{4}
+}
+func (m *BudgetAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BudgetAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BudgetAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BudgetAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BudgetAllowance.Merge(m, src)
+}
+func (m *BudgetAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *BudgetAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_BudgetAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BudgetAllowance proto.InternalMessageInfo
+
+func (m *BudgetAllowance) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *BudgetAllowance) GetAllowance() uint64 {
+	if m != nil {
+		return m.Allowance
+	}
+	return 0
+}
+
+// GiveableBalance is the giveable allowance a single address has left within a budget epoch
+type GiveableBalance struct {
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Remaining uint64 `protobuf:"varint,2,opt,name=remaining,proto3" json:"remaining,omitempty"`
+	Epoch     uint64 `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (m *GiveableBalance) Reset()         { *m = GiveableBalance{} }
+func (m *GiveableBalance) String() string { return proto.CompactTextString(m) }
+func (*GiveableBalance) ProtoMessage()    {}
+func (*GiveableBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ea50ed9b2975d1, []int{5}
+}
+func (m *GiveableBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GiveableBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GiveableBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GiveableBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GiveableBalance.Merge(m, src)
+}
+func (m *GiveableBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *GiveableBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_GiveableBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GiveableBalance proto.InternalMessageInfo
+
+func (m *GiveableBalance) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *GiveableBalance) GetRemaining() uint64 {
+	if m != nil {
+		return m.Remaining
+	}
+	return 0
+}
+
+func (m *GiveableBalance) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+// PeriodBucket is the kudos received by a single address within one day bucket
+type PeriodBucket struct {
+	Day     uint64 `protobuf:"varint,1,opt,name=day,proto3" json:"day,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *PeriodBucket) Reset()         { *m = PeriodBucket{} }
+func (m *PeriodBucket) String() string { return proto.CompactTextString(m) }
+func (*PeriodBucket) ProtoMessage()    {}
+func (*PeriodBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ea50ed9b2975d1, []int{6}
+}
+func (m *PeriodBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeriodBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeriodBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeriodBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeriodBucket.Merge(m, src)
+}
+func (m *PeriodBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeriodBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeriodBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeriodBucket proto.InternalMessageInfo
+
+func (m *PeriodBucket) GetDay() uint64 {
+	if m != nil {
+		return m.Day
+	}
+	return 0
+}
+
+func (m *PeriodBucket) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PeriodBucket) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+// DailyQuota is the daily sending quota tracker of a single address
+type DailyQuota struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Used    uint64 `protobuf:"varint,2,opt,name=used,proto3" json:"used,omitempty"`
+	ResetAt int64  `protobuf:"varint,3,opt,name=reset_at,json=resetAt,proto3" json:"reset_at,omitempty" yaml:"reset_at"`
+}
+
+func (m *DailyQuota) Reset()         { *m = DailyQuota{} }
+func (m *DailyQuota) String() string { return proto.CompactTextString(m) }
+func (*DailyQuota) ProtoMessage()    {}
+func (*DailyQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95ea50ed9b2975d1, []int{7}
+}
+func (m *DailyQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DailyQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DailyQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DailyQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DailyQuota.Merge(m, src)
+}
+func (m *DailyQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *DailyQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_DailyQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DailyQuota proto.InternalMessageInfo
+
+func (m *DailyQuota) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *DailyQuota) GetUsed() uint64 {
+	if m != nil {
+		return m.Used
+	}
+	return 0
+}
+
+func (m *DailyQuota) GetResetAt() int64 {
+	if m != nil {
+		return m.ResetAt
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "kudos.GenesisState")
+	proto.RegisterType((*KudosBalance)(nil), "kudos.KudosBalance")
+	proto.RegisterType((*KudosSentTotal)(nil), "kudos.KudosSentTotal")
+	proto.RegisterType((*KudosLifetimeTotal)(nil), "kudos.KudosLifetimeTotal")
+	proto.RegisterType((*BudgetAllowance)(nil), "kudos.BudgetAllowance")
+	proto.RegisterType((*GiveableBalance)(nil), "kudos.GiveableBalance")
+	proto.RegisterType((*PeriodBucket)(nil), "kudos.PeriodBucket")
+	proto.RegisterType((*DailyQuota)(nil), "kudos.DailyQuota")
+}
+
+func init() { proto.RegisterFile("kudos/genesis.proto", fileDescriptor_95ea50ed9b2975d1) }
+
+var fileDescriptor_95ea50ed9b2975d1 = []byte{
+	// 985 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xdf, 0x6e, 0xdb, 0xb6,
+	0x17, 0x8e, 0xf3, 0x3f, 0xc7, 0x8e, 0xed, 0x30, 0x6e, 0x7e, 0xaa, 0x7f, 0xad, 0x6d, 0xf0, 0x2a,
+	0xc0, 0x10, 0x1b, 0x68, 0x51, 0x0c, 0xdb, 0xd5, 0xaa, 0x14, 0xe8, 0x86, 0x15, 0x43, 0x43, 0xaf,
+	0x17, 0xdb, 0x8d, 0x40, 0x4b, 0x9c, 0xa2, 0x45, 0x12, 0x5d, 0x51, 0xca, 0xe6, 0xb7, 0xd8, 0xf3,
+	0xec, 0x09, 0x7a, 0xd9, 0xcb, 0x5d, 0x19, 0x43, 0xf2, 0x06, 0x79, 0x82, 0x81, 0x7f, 0x64, 0x8b,
+	0x32, 0x16, 0x60, 0x37, 0x06, 0x79, 0xbe, 0xf3, 0x7d, 0xe7, 0x88, 0xfc, 0x48, 0x1a, 0x4e, 0x6f,
+	0x8a, 0x80, 0x8b, 0x49, 0xc8, 0x52, 0x26, 0x22, 0x31, 0x9e, 0x67, 0x3c, 0xe7, 0x68, 0x4f, 0x05,
+	0xfb, 0xbd, 0x90, 0x87, 0x5c, 0x45, 0x26, 0x72, 0xa4, 0xc1, 0x3e, 0xd2, 0x8c, 0x39, 0xcd, 0x68,
+	0x62, 0x08, 0xfd, 0x9e, 0x8e, 0xf9, 0x34, 0x67, 0x21, 0xcf, 0x16, 0x26, 0x7a, 0xa2, 0xa3, 0x01,
+	0xf3, 0xe9, 0xc2, 0x26, 0x0b, 0x46, 0x05, 0x4f, 0xed, 0x58, 0xc6, 0x02, 0xc6, 0x12, 0x9b, 0xfa,
+	0xb1, 0x60, 0xa5, 0x1a, 0xfe, 0xb3, 0x05, 0xad, 0xb7, 0xba, 0xcd, 0x69, 0x4e, 0x73, 0x86, 0x5e,
+	0xc1, 0xe1, 0x8c, 0xc6, 0x34, 0xf5, 0x99, 0x70, 0x1a, 0xa3, 0x9d, 0xf3, 0xe6, 0x8b, 0xd3, 0xb1,
+	0xa2, 0x8d, 0xbf, 0x97, 0xbf, 0xae, 0xc6, 0xdc, 0xdd, 0x4f, 0xcb, 0xe1, 0x16, 0x59, 0xa5, 0xa2,
+	0x97, 0x70, 0x70, 0x1d, 0x89, 0x9c, 0x67, 0x0b, 0x67, 0x7b, 0x93, 0xf5, 0xad, 0x86, 0x0c, 0xab,
+	0xcc, 0x44, 0x97, 0xd0, 0x31, 0x43, 0xcf, 0xe7, 0x45, 0x9a, 0xb3, 0xcc, 0xd9, 0x19, 0x35, 0xce,
+	0x77, 0xdd, 0xfe, 0xc3, 0x72, 0x78, 0xb6, 0xa0, 0x49, 0xfc, 0x35, 0xae, 0x25, 0x60, 0xd2, 0x36,
+	0x91, 0x4b, 0x1d, 0x40, 0x57, 0xd0, 0x0a, 0x68, 0x14, 0x2f, 0xbc, 0x8f, 0x05, 0xcf, 0xa9, 0x70,
+	0x76, 0x55, 0xf9, 0x13, 0x53, 0xfe, 0x8d, 0x84, 0xae, 0x24, 0xe2, 0xfe, 0x5f, 0x16, 0x7f, 0x58,
+	0x0e, 0x4f, 0xb5, 0x70, 0x95, 0x84, 0x49, 0x33, 0x58, 0x25, 0x0a, 0xf4, 0x05, 0xec, 0xeb, 0x8d,
+	0x70, 0xf6, 0x46, 0x8d, 0xf3, 0xe6, 0x8b, 0x63, 0x23, 0xf6, 0x5e, 0x05, 0xcd, 0x57, 0x98, 0x14,
+	0x44, 0xa0, 0x29, 0x58, 0x9a, 0x7b, 0x39, 0xcf, 0x69, 0x2c, 0x9c, 0x7d, 0x55, 0xfe, 0x49, 0xf5,
+	0xeb, 0xa7, 0x2c, 0xcd, 0x7f, 0x94, 0xa8, 0xdb, 0x37, 0x2d, 0x20, 0xdd, 0x42, 0x85, 0x87, 0x09,
+	0x88, 0x32, 0x4d, 0xa0, 0x9f, 0xa0, 0x3d, 0x67, 0x59, 0xc4, 0x03, 0x6f, 0x56, 0xf8, 0x37, 0x2c,
+	0x17, 0xce, 0x81, 0xb5, 0xa8, 0xef, 0x15, 0xe8, 0x2a, 0xcc, 0x7d, 0x6e, 0x44, 0x9f, 0x68, 0x51,
+	0x9b, 0x88, 0xc9, 0xf1, 0xbc, 0x92, 0x2c, 0xd0, 0x2b, 0x00, 0x63, 0xa8, 0x88, 0x09, 0xe7, 0x50,
+	0xc9, 0x76, 0x8c, 0xec, 0xa5, 0x71, 0x9a, 0xf9, 0xc2, 0x4a, 0x22, 0x62, 0x70, 0x62, 0x66, 0x0b,
+	0x6f, 0xe5, 0x8f, 0x23, 0xc5, 0x3e, 0xab, 0xb3, 0x8d, 0x45, 0x46, 0xa6, 0x2f, 0x47, 0xf7, 0xb5,
+	0x41, 0xc7, 0xa4, 0xeb, 0xdb, 0x14, 0x81, 0x66, 0xd0, 0x89, 0xa3, 0x5f, 0x58, 0x1e, 0x25, 0xac,
+	0x5c, 0x50, 0x50, 0x45, 0x9e, 0x56, 0x17, 0xf4, 0x9d, 0x49, 0xd1, 0x8b, 0x3a, 0x30, 0x75, 0x8c,
+	0x61, 0x6a, 0x7c, 0x4c, 0xda, 0x71, 0x35, 0x5d, 0xa0, 0x1f, 0xa0, 0xa9, 0x0e, 0x8f, 0x27, 0xa4,
+	0xe1, 0x9d, 0xe6, 0xa8, 0x51, 0xf5, 0x8b, 0x44, 0xd4, 0x49, 0xa8, 0x6f, 0x56, 0x85, 0x83, 0x09,
+	0x04, 0xab, 0x3c, 0x34, 0x85, 0xb6, 0x5f, 0x64, 0x99, 0xdc, 0x4b, 0x7d, 0x02, 0x9d, 0x96, 0xe5,
+	0x9a, 0xa9, 0x0a, 0xd6, 0xb7, 0xc9, 0xa6, 0x60, 0x72, 0x6c, 0x02, 0x3a, 0x1b, 0x5d, 0xc0, 0x81,
+	0x46, 0x84, 0x73, 0x3c, 0xda, 0xd9, 0x54, 0x33, 0x27, 0xc9, 0xe4, 0x20, 0x0a, 0x5d, 0x3d, 0x94,
+	0x0d, 0xa6, 0x41, 0x94, 0x86, 0xc2, 0x69, 0x5b, 0x4e, 0xd4, 0xbc, 0xa9, 0x41, 0xdd, 0xa1, 0xe9,
+	0xe6, 0x7f, 0xa5, 0x13, 0x6d, 0x32, 0x26, 0x1d, 0x61, 0x11, 0x94, 0x03, 0x66, 0x45, 0x10, 0xb2,
+	0xdc, 0xa3, 0x71, 0xcc, 0x7f, 0xd3, 0x0e, 0xe8, 0x58, 0x0e, 0x70, 0x15, 0xfe, 0xba, 0x84, 0xeb,
+	0x0e, 0xd8, 0xa0, 0x63, 0xd2, 0x9d, 0xd9, 0x14, 0x55, 0x26, 0x8c, 0x6e, 0x19, 0x9d, 0xc5, 0x6c,
+	0x6d, 0xb4, 0xae, 0x55, 0xe6, 0xad, 0xc1, 0xff, 0xc5, 0x68, 0x1b, 0x74, 0x4c, 0xba, 0xa1, 0x4d,
+	0x11, 0xe8, 0x2b, 0x68, 0xca, 0xab, 0x31, 0x99, 0xe7, 0x91, 0x5c, 0xe3, 0x13, 0xeb, 0xd2, 0x20,
+	0x2b, 0xc4, 0xac, 0x73, 0x35, 0x17, 0xbd, 0x03, 0xb4, 0x9e, 0xae, 0x2e, 0x2e, 0xa4, 0x2e, 0xae,
+	0xe7, 0x0f, 0xcb, 0xe1, 0x53, 0xdd, 0xc6, 0x66, 0x0e, 0x26, 0x27, 0xeb, 0x60, 0x79, 0x7d, 0x7d,
+	0x80, 0x63, 0x7d, 0x47, 0x7b, 0x85, 0xa0, 0x21, 0x13, 0xce, 0xa9, 0x6a, 0x05, 0x55, 0x5a, 0x61,
+	0xc9, 0x07, 0x09, 0xb9, 0xcf, 0xcc, 0x77, 0xf6, 0xd6, 0x05, 0x56, 0x34, 0x4c, 0x5a, 0xd9, 0x3a,
+	0x55, 0x20, 0x1f, 0xcc, 0x06, 0x7a, 0x19, 0x8f, 0x63, 0x7e, 0xcb, 0x32, 0xa7, 0xa7, 0x5c, 0xd9,
+	0xb7, 0xfc, 0x40, 0x0c, 0xa8, 0x1d, 0x5f, 0x3b, 0x49, 0x35, 0x01, 0x4c, 0xda, 0xc2, 0x22, 0x61,
+	0x17, 0x5a, 0xd5, 0x47, 0x01, 0x39, 0x70, 0x40, 0x83, 0x20, 0x63, 0x42, 0x3e, 0x1d, 0x8d, 0xf3,
+	0x23, 0x52, 0x4e, 0x25, 0x62, 0x76, 0xc3, 0xd9, 0x96, 0x0b, 0x45, 0xca, 0x29, 0xfe, 0x06, 0xda,
+	0xf6, 0x25, 0xf9, 0x88, 0x4a, 0x0f, 0xf6, 0xd4, 0xa1, 0x36, 0x1a, 0x7a, 0x82, 0xdf, 0x00, 0xda,
+	0xbc, 0x15, 0xfe, 0xb3, 0xca, 0x77, 0xd0, 0xa9, 0xd9, 0xf7, 0x11, 0x89, 0x67, 0x70, 0xb4, 0x72,
+	0xb1, 0x91, 0x59, 0x07, 0xb0, 0x07, 0x9d, 0x9a, 0x45, 0x1f, 0x97, 0xca, 0x58, 0x42, 0xa3, 0x34,
+	0x4a, 0xc3, 0x52, 0x6a, 0x15, 0x90, 0xbd, 0xb2, 0x39, 0xf7, 0xaf, 0xf5, 0xbb, 0x48, 0xf4, 0x04,
+	0x13, 0x68, 0x55, 0x5f, 0x00, 0xd4, 0x85, 0x9d, 0x80, 0x2e, 0x94, 0xf2, 0x2e, 0x91, 0xc3, 0x6a,
+	0xbd, 0x6d, 0xbb, 0xde, 0x19, 0xec, 0xd3, 0x44, 0x7a, 0xcf, 0x48, 0x9a, 0x19, 0xfe, 0x15, 0x60,
+	0xfd, 0x56, 0x3e, 0xd2, 0x2f, 0x82, 0xdd, 0x42, 0xb0, 0xc0, 0xb4, 0xaa, 0xc6, 0x68, 0x0c, 0x87,
+	0x19, 0x13, 0xf2, 0x68, 0x6b, 0xd5, 0x1d, 0xf7, 0xf4, 0x61, 0x39, 0xec, 0x94, 0x36, 0xd5, 0x08,
+	0x26, 0x07, 0x6a, 0xf8, 0x3a, 0x77, 0xaf, 0x3e, 0xdd, 0x0d, 0x1a, 0x9f, 0xef, 0x06, 0x8d, 0xbf,
+	0xef, 0x06, 0x8d, 0x3f, 0xee, 0x07, 0x5b, 0x9f, 0xef, 0x07, 0x5b, 0x7f, 0xdd, 0x0f, 0xb6, 0x7e,
+	0xfe, 0x32, 0x8c, 0xf2, 0xeb, 0x62, 0x36, 0xf6, 0x79, 0x32, 0x99, 0xd3, 0xdb, 0x98, 0xa5, 0x37,
+	0x3c, 0x4f, 0x26, 0x3e, 0x17, 0x09, 0x17, 0x17, 0xca, 0xb9, 0x17, 0x09, 0x0f, 0x8a, 0x98, 0x4d,
+	0x7e, 0x9f, 0xa8, 0xe9, 0x24, 0x5f, 0xcc, 0x99, 0x98, 0xed, 0xab, 0xbf, 0x33, 0x2f, 0xff, 0x19,
+	0x00, 0x80, 0x9a, 0x99, 0x20, 0x7a, 0x09, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SeasonRollover.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xa2
+	if len(m.RedeemUsages) > 0 {
+		for iNdEx := len(m.RedeemUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RedeemUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if m.RedemptionCounter != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.RedemptionCounter))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if len(m.Redemptions) > 0 {
+		for iNdEx := len(m.Redemptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Redemptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.GiveableBalances) > 0 {
+		for iNdEx := len(m.GiveableBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.GiveableBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if len(m.BudgetAllowances) > 0 {
+		for iNdEx := len(m.BudgetAllowances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BudgetAllowances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x7a
+		}
+	}
+	if len(m.SeasonStandings) > 0 {
+		for iNdEx := len(m.SeasonStandings) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SeasonStandings[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.Seasons) > 0 {
+		for iNdEx := len(m.Seasons) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Seasons[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	{
+		size, err := m.CurrentSeason.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size, err := m.DecayState.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if len(m.LifetimeTotals) > 0 {
+		for iNdEx := len(m.LifetimeTotals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LifetimeTotals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.CategoryBalances) > 0 {
+		for iNdEx := len(m.CategoryBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CategoryBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.Categories) > 0 {
+		for iNdEx := len(m.Categories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Categories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.PeriodBuckets) > 0 {
+		for iNdEx := len(m.PeriodBuckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodBuckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.SentTotals) > 0 {
+		for iNdEx := len(m.SentTotals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SentTotals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.DailyQuotas) > 0 {
+		for iNdEx := len(m.DailyQuotas) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DailyQuotas[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.HistoryCounter != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.HistoryCounter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Balances) > 0 {
+		for iNdEx := len(m.Balances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KudosBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KudosBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KudosBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Balance != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Balance))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KudosSentTotal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KudosSentTotal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KudosSentTotal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Total != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *KudosLifetimeTotal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KudosLifetimeTotal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KudosLifetimeTotal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Total != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Total))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BudgetAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BudgetAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BudgetAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Allowance != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Allowance))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GiveableBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GiveableBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GiveableBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Remaining != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Remaining))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PeriodBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeriodBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeriodBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Day != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Day))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DailyQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DailyQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DailyQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ResetAt != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ResetAt))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Used != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Used))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Balances) > 0 {
+		for _, e := range m.Balances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.HistoryCounter != 0 {
+		n += 1 + sovGenesis(uint64(m.HistoryCounter))
+	}
+	if len(m.DailyQuotas) > 0 {
+		for _, e := range m.DailyQuotas {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.SentTotals) > 0 {
+		for _, e := range m.SentTotals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PeriodBuckets) > 0 {
+		for _, e := range m.PeriodBuckets {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Categories) > 0 {
+		for _, e := range m.Categories {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CategoryBalances) > 0 {
+		for _, e := range m.CategoryBalances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LifetimeTotals) > 0 {
+		for _, e := range m.LifetimeTotals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.DecayState.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.CurrentSeason.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Seasons) > 0 {
+		for _, e := range m.Seasons {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.SeasonStandings) > 0 {
+		for _, e := range m.SeasonStandings {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BudgetAllowances) > 0 {
+		for _, e := range m.BudgetAllowances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.GiveableBalances) > 0 {
+		for _, e := range m.GiveableBalances {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Redemptions) > 0 {
+		for _, e := range m.Redemptions {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.RedemptionCounter != 0 {
+		n += 2 + sovGenesis(uint64(m.RedemptionCounter))
+	}
+	if len(m.RedeemUsages) > 0 {
+		for _, e := range m.RedeemUsages {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.SeasonRollover.Size()
+	n += 2 + l + sovGenesis(uint64(l))
+	return n
+}
+
+func (m *KudosBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Balance != 0 {
+		n += 1 + sovGenesis(uint64(m.Balance))
+	}
+	return n
+}
+
+func (m *KudosSentTotal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Total != 0 {
+		n += 1 + sovGenesis(uint64(m.Total))
+	}
+	return n
+}
+
+func (m *KudosLifetimeTotal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Total != 0 {
+		n += 1 + sovGenesis(uint64(m.Total))
+	}
+	return n
+}
+
+func (m *BudgetAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Allowance != 0 {
+		n += 1 + sovGenesis(uint64(m.Allowance))
+	}
+	return n
+}
+
+func (m *GiveableBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Remaining != 0 {
+		n += 1 + sovGenesis(uint64(m.Remaining))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovGenesis(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *PeriodBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Day != 0 {
+		n += 1 + sovGenesis(uint64(m.Day))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovGenesis(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *DailyQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Used != 0 {
+		n += 1 + sovGenesis(uint64(m.Used))
+	}
+	if m.ResetAt != 0 {
+		n += 1 + sovGenesis(uint64(m.ResetAt))
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Balances = append(m.Balances, KudosBalance{})
+			if err := m.Balances[len(m.Balances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, KudosHistory{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryCounter", wireType)
+			}
+			m.HistoryCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DailyQuotas", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DailyQuotas = append(m.DailyQuotas, DailyQuota{})
+			if err := m.DailyQuotas[len(m.DailyQuotas)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SentTotals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SentTotals = append(m.SentTotals, KudosSentTotal{})
+			if err := m.SentTotals[len(m.SentTotals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodBuckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodBuckets = append(m.PeriodBuckets, PeriodBucket{})
+			if err := m.PeriodBuckets[len(m.PeriodBuckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Categories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Categories = append(m.Categories, Category{})
+			if err := m.Categories[len(m.Categories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CategoryBalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CategoryBalances = append(m.CategoryBalances, CategoryBalance{})
+			if err := m.CategoryBalances[len(m.CategoryBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LifetimeTotals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LifetimeTotals = append(m.LifetimeTotals, KudosLifetimeTotal{})
+			if err := m.LifetimeTotals[len(m.LifetimeTotals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayState", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DecayState.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSeason", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentSeason.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seasons", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seasons = append(m.Seasons, Season{})
+			if err := m.Seasons[len(m.Seasons)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeasonStandings", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SeasonStandings = append(m.SeasonStandings, SeasonStanding{})
+			if err := m.SeasonStandings[len(m.SeasonStandings)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BudgetAllowances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BudgetAllowances = append(m.BudgetAllowances, BudgetAllowance{})
+			if err := m.BudgetAllowances[len(m.BudgetAllowances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GiveableBalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GiveableBalances = append(m.GiveableBalances, GiveableBalance{})
+			if err := m.GiveableBalances[len(m.GiveableBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Redemptions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Redemptions = append(m.Redemptions, Redemption{})
+			if err := m.Redemptions[len(m.Redemptions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionCounter", wireType)
+			}
+			m.RedemptionCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedemptionCounter |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RedeemUsages = append(m.RedeemUsages, RedeemUsage{})
+			if err := m.RedeemUsages[len(m.RedeemUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SeasonRollover", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SeasonRollover.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KudosBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KudosBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KudosBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			m.Balance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Balance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KudosSentTotal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KudosSentTotal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KudosSentTotal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KudosLifetimeTotal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KudosLifetimeTotal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KudosLifetimeTotal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			m.Total = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Total |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BudgetAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BudgetAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BudgetAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			m.Allowance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Allowance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GiveableBalance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GiveableBalance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GiveableBalance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remaining", wireType)
+			}
+			m.Remaining = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Remaining |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeriodBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeriodBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeriodBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Day", wireType)
+			}
+			m.Day = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Day |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DailyQuota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DailyQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DailyQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			m.Used = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Used |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetAt", wireType)
+			}
+			m.ResetAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResetAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,550 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+func TestGenesisState_Validate(t *testing.T) {
+	alice := sdk.AccAddress([]byte("alice_______________")).String()
+	bob := sdk.AccAddress([]byte("bob_________________")).String()
+	carol := sdk.AccAddress([]byte("carol_______________")).String()
+
+	validHistory := func() []types.KudosHistory {
+		return []types.KudosHistory{
+			{Id: 1, FromAddress: alice, ToAddress: bob, Amount: 10},
+			{Id: 2, FromAddress: alice, ToAddress: carol, Amount: 5},
+			{Id: 3, FromAddress: carol, ToAddress: bob, Amount: 2},
+		}
+	}
+	validBalances := func() []types.KudosBalance {
+		return []types.KudosBalance{
+			{Address: bob, Balance: 12},
+			{Address: carol, Balance: 5},
+		}
+	}
+
+	validLifetimeTotals := func() []types.KudosLifetimeTotal {
+		return []types.KudosLifetimeTotal{
+			{Address: bob, Total: 12},
+			{Address: carol, Total: 5},
+		}
+	}
+
+	validSentTotals := func() []types.KudosSentTotal {
+		return []types.KudosSentTotal{
+			{Address: alice, Total: 15},
+			{Address: carol, Total: 2},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		genState  *types.GenesisState
+		expectErr bool
+		errMsg    string
+	}{
+		{
+			name:     "default genesis",
+			genState: types.DefaultGenesisState(),
+		},
+		{
+			name: "valid complete history",
+			genState: types.NewGenesisState(types.DefaultParams(), validBalances(), validHistory(), 3, []types.DailyQuota{
+				{Address: alice, Used: 15, ResetAt: 1700000000},
+			}, validSentTotals(), nil, nil, nil, validLifetimeTotals(), types.DecayState{}, types.Season{}, nil, nil, nil, nil, nil, 0, nil, types.SeasonRolloverState{}),
+		},
+		{
+			name: "valid pruned history skips balance check",
+			genState: types.NewGenesisState(
+				types.DefaultParams(),
+				[]types.KudosBalance{{Address: bob, Balance: 100}},
+				validHistory()[1:],
+				3,
+				nil, nil, nil, nil, nil,
+				[]types.KudosLifetimeTotal{{Address: bob, Total: 100}}, types.DecayState{}, types.Season{}, nil, nil, nil, nil,
+				nil, 0, nil, types.SeasonRolloverState{},
+			),
+		},
+		{
+			name: "invalid params",
+			genState: types.NewGenesisState(
+				types.NewParams(0, types.DailyQuotaWindowSeconds, types.DefaultMaxCommentLength, types.DefaultLeaderboardLimit, types.DefaultRevokeGracePeriodSeconds, 0, types.DefaultDecayEpochSeconds, types.DefaultDecayAccountsPerBlock, 0, types.DefaultSeasonAccountsPerBlock, false, types.DefaultBudgetPerEpoch, types.DefaultBudgetEpochSeconds, 0, "", nil, "", 0, types.DefaultRedeemEpochSeconds, 0),
+				nil, nil, 0, nil, nil, nil, nil, nil, nil, types.DecayState{}, types.Season{}, nil, nil, nil, nil,
+				nil, 0, nil, types.SeasonRolloverState{},
+			),
+			expectErr: true,
+			errMsg:    "params: daily limit must be greater than 0",
+		},
+		{
+			name: "invalid balance address",
+			genState: types.NewGenesisState(
+				types.DefaultParams(),
+				[]types.KudosBalance{{Address: "cosmos1invalid", Balance: 1}},
+				nil, 0, nil, nil, nil, nil, nil, nil, types.DecayState{}, types.Season{}, nil, nil, nil, nil,
+				nil, 0, nil, types.SeasonRolloverState{},
+			),
+			expectErr: true,
+			errMsg:    "balances[0]: invalid address",
+		},
+		{
+			name: "duplicate balance",
+			genState: types.NewGenesisState(
+				types.DefaultParams(),
+				[]types.KudosBalance{{Address: bob, Balance: 1}, {Address: bob, Balance: 2}},
+				nil, 0, nil, nil, nil, nil, nil, nil, types.DecayState{}, types.Season{}, nil, nil, nil, nil,
+				nil, 0, nil, types.SeasonRolloverState{},
+			),
+			expectErr: true,
+			errMsg:    "balances[1]: duplicate balance",
+		},
+		{
+			name: "duplicate history id",
+			genState: types.NewGenesisState(types.DefaultParams(), nil, []types.KudosHistory{
+				{Id: 1, FromAddress: alice, ToAddress: bob, Amount: 1},
+				{Id: 1, FromAddress: alice, ToAddress: bob, Amount: 1},
+			}, 1, nil, nil, nil, nil, nil, nil, types.DecayState{}, types.Season{}, nil, nil, nil, nil, nil, 0, nil, types.SeasonRolloverState{}),
+			expectErr: true,
+			errMsg:    "history[1]: duplicate id 1",
+		},
+		{
+			name: "non-contiguous history ids",
+			genState: types.NewGenesisState(types.DefaultParams(), nil, []types.KudosHistory{
+				{Id: 1, FromAddress: alice, ToAddress: bob, Amount: 1},
+				{Id: 3, FromAddress: alice, ToAddress: bob, Amount: 1},
+			}, 3, nil, nil, nil, nil, nil, nil, types.DecayState{}, types.Season{}, nil, nil, nil, nil, nil, 0, nil, types.SeasonRolloverState{}),
+			expectErr: true,
+			errMsg:    "history[1]: id 3 does not follow previous id 1",
+		},
+		{
+			name: "invalid history recipient",
+			genState: types.NewGenesisState(types.DefaultParams(), nil, []types.KudosHistory{
+				{Id: 1, FromAddress: alice, ToAddress: "bad", Amount: 1},
+			}, 1, nil, nil, nil, nil, nil, nil, types.DecayState{}, types.Season{}, nil, nil, nil, nil, nil, 0, nil, types.SeasonRolloverState{}),
+			expectErr: true,
+			errMsg:    "history[0] (id 1): invalid to address",
+		},
+		{
+			name:      "history counter below highest id",
+			genState:  types.NewGenesisState(types.DefaultParams(), validBalances(), validHistory(), 2, nil, nil, nil, nil, nil, validLifetimeTotals(), types.DecayState{}, types.Season{}, nil, nil, nil, nil, nil, 0, nil, types.SeasonRolloverState{}),
+			expectErr: true,
+			errMsg:    "history counter 2 does not match the highest history id 3",
+		},
+		{
+			name:      "history counter above highest id",
+			genState:  types.NewGenesisState(types.DefaultParams(), validBalances(), validHistory(), 9, nil, nil, nil, nil, nil, validLifetimeTotals(), types.DecayState{}, types.Season{}, nil, nil, nil, nil, nil, 0, nil, types.SeasonRolloverState{}),
+			expectErr: true,
+			errMsg:    "history counter 9 does not match the highest history id 3",
+		},
+		{
+			// governance may lower the limit while a window is open
+			name: "quota used above a lowered limit",
+			genState: types.NewGenesisState(types.DefaultParams(), nil, nil, 0, []types.DailyQuota{
+				{Address: alice, Used: types.DefaultDailyLimit + 1, ResetAt: 1700000000},
+			}, nil, nil, nil, nil, nil, types.DecayState{}, types.Season{}, nil, nil, nil, nil, nil, 0, nil, types.SeasonRolloverState{}),
+			expectErr: false,
+		},
+		{
+			name: "decayed balances below history",
+			genState: types.NewGenesisState(
+				types.DefaultParams(),
+				[]types.KudosBalance{{Address: bob, Balance: 6}, {Address: carol, Balance: 0}},
+				validHistory(), 3, nil, validSentTotals(), nil, nil, nil,
+				validLifetimeTotals(), types.DecayState{Epoch: 1, InProgress: true, NextAddress: carol}, types.Season{}, nil, nil, nil, nil,
+				nil, 0, nil, types.SeasonRolloverState{},
+			),
+		},
+		{
+			name: "balance above lifetime total",
+			genState: types.NewGenesisState(
+				types.DefaultParams(), validBalances(), validHistory(), 3, nil, nil, nil, nil, nil,
+				[]types.KudosLifetimeTotal{{Address: bob, Total: 10}, {Address: carol, Total: 5}}, types.DecayState{}, types.Season{}, nil, nil, nil, nil,
+				nil, 0, nil, types.SeasonRolloverState{},
+			),
+			expectErr: true,
+			errMsg:    "balances[0]: address " + bob + " has balance 12 above its lifetime total 10",
+		},
+		{
+			name: "balance does not match history",
+			genState: types.NewGenesisState(
+				types.DefaultParams(),
+				[]types.KudosBalance{{Address: bob, Balance: 11}, {Address: carol, Balance: 5}},
+				validHistory(), 3, nil, nil, nil, nil, nil, validLifetimeTotals(), types.DecayState{}, types.Season{}, nil, nil, nil, nil,
+				nil, 0, nil, types.SeasonRolloverState{},
+			),
+			expectErr: true,
+			errMsg:    "balances[0]: address " + bob + " has balance 11 but received 12 in history",
+		},
+		{
+			name: "redeemed balance below history",
+			genState: types.NewGenesisState(
+				types.DefaultParams(),
+				[]types.KudosBalance{{Address: bob, Balance: 11}, {Address: carol, Balance: 5}},
+				validHistory(), 3, nil, validSentTotals(), nil, nil, nil, validLifetimeTotals(), types.DecayState{}, types.Season{}, nil, nil, nil, nil,
+				nil, 1, nil, types.SeasonRolloverState{},
+			),
+		},
+		{
+			name: "lifetime total does not match history",
+			genState: types.NewGenesisState(
+				types.DefaultParams(),
+				[]types.KudosBalance{{Address: bob, Balance: 11}, {Address: carol, Balance: 5}},
+				validHistory(), 3, nil, nil, nil, nil, nil,
+				[]types.KudosLifetimeTotal{{Address: bob, Total: 11}, {Address: carol, Total: 5}}, types.DecayState{}, types.Season{}, nil, nil, nil, nil,
+				nil, 0, nil, types.SeasonRolloverState{},
+			),
+			expectErr: true,
+			errMsg:    "lifetime_totals[0]: address " + bob + " has total 11 but received 12 in history",
+		},
+		{
+			name: "decay next address without a pass in progress",
+			genState: types.NewGenesisState(types.DefaultParams(), nil, nil, 0, nil, nil, nil, nil, nil, nil,
+				types.DecayState{Epoch: 1, NextAddress: bob}, types.Season{}, nil, nil, nil, nil,
+				nil, 0, nil, types.SeasonRolloverState{},
+			),
+			expectErr: true,
+			errMsg:    "decay_state: next address set without a pass in progress",
+		},
+		{
+			name: "recipient missing balance entry",
+			genState: types.NewGenesisState(
+				types.DefaultParams(),
+				[]types.KudosBalance{{Address: bob, Balance: 12}},
+				validHistory(), 3, nil, nil, nil, nil, nil, validLifetimeTotals(), types.DecayState{}, types.Season{}, nil, nil, nil, nil,
+				nil, 0, nil, types.SeasonRolloverState{},
+			),
+			expectErr: true,
+			errMsg:    "recipient " + carol + " has no balance entry but received 5 in history",
+		},
+		{
+			name: "invalid sent total address",
+			genState: types.NewGenesisState(types.DefaultParams(), nil, nil, 0, nil,
+				[]types.KudosSentTotal{{Address: "bad", Total: 1}},
+				nil, nil, nil, nil, types.DecayState{}, types.Season{}, nil, nil, nil, nil,
+				nil, 0, nil, types.SeasonRolloverState{},
+			),
+			expectErr: true,
+			errMsg:    "sent_totals[0]: invalid address",
+		},
+		{
+			name: "duplicate sent total",
+			genState: types.NewGenesisState(types.DefaultParams(), validBalances(), validHistory()[1:], 3, nil,
+				[]types.KudosSentTotal{{Address: alice, Total: 1}, {Address: alice, Total: 2}},
+				nil, nil, nil, validLifetimeTotals(), types.DecayState{}, types.Season{}, nil, nil, nil, nil,
+				nil, 0, nil, types.SeasonRolloverState{},
+			),
+			expectErr: true,
+			errMsg:    "sent_totals[1]: duplicate sent total",
+		},
+		{
+			name: "sent total does not match history",
+			genState: types.NewGenesisState(types.DefaultParams(), validBalances(), validHistory(), 3, nil,
+				[]types.KudosSentTotal{{Address: alice, Total: 10}, {Address: carol, Total: 2}},
+				nil, nil, nil, validLifetimeTotals(), types.DecayState{}, types.Season{}, nil, nil, nil, nil,
+				nil, 0, nil, types.SeasonRolloverState{},
+			),
+			expectErr: true,
+			errMsg:    "sent_totals[0]: address " + alice + " has total 10 but sent 15 in history",
+		},
+		{
+			name: "sender missing sent total entry",
+			genState: types.NewGenesisState(types.DefaultParams(), validBalances(), validHistory(), 3, nil,
+				[]types.KudosSentTotal{{Address: alice, Total: 15}},
+				nil, nil, nil, validLifetimeTotals(), types.DecayState{}, types.Season{}, nil, nil, nil, nil,
+				nil, 0, nil, types.SeasonRolloverState{},
+			),
+			expectErr: true,
+			errMsg:    "sender " + carol + " has no sent total entry but sent 2 in history",
+		},
+		{
+			name: "invalid period bucket address",
+			genState: types.NewGenesisState(types.DefaultParams(), nil, nil, 0, nil, nil,
+				[]types.PeriodBucket{{Day: 19700, Address: "bad", Amount: 1}}, nil, nil, nil, types.DecayState{}, types.Season{}, nil, nil, nil, nil,
+				nil, 0, nil, types.SeasonRolloverState{},
+			),
+			expectErr: true,
+			errMsg:    "period_buckets[0]: invalid address",
+		},
+		{
+			name: "zero period bucket amount",
+			genState: types.NewGenesisState(types.DefaultParams(), nil, nil, 0, nil, nil,
+				[]types.PeriodBucket{{Day: 19700, Address: bob, Amount: 0}}, nil, nil, nil, types.DecayState{}, types.Season{}, nil, nil, nil, nil,
+				nil, 0, nil, types.SeasonRolloverState{},
+			),
+			expectErr: true,
+			errMsg:    "period_buckets[0]: amount must be greater than 0",
+		},
+		{
+			name: "duplicate period bucket",
+			genState: types.NewGenesisState(types.DefaultParams(), nil, nil, 0, nil, nil,
+				[]types.PeriodBucket{{Day: 19700, Address: bob, Amount: 1}, {Day: 19700, Address: bob, Amount: 2}}, nil, nil, nil, types.DecayState{}, types.Season{}, nil, nil, nil, nil,
+				nil, 0, nil, types.SeasonRolloverState{},
+			),
+			expectErr: true,
+			errMsg:    "period_buckets[1]: duplicate bucket for address " + bob + " on day 19700",
+		},
+		{
+			name: "valid categories",
+			genState: types.NewGenesisState(types.DefaultParams(), validBalances(), []types.KudosHistory{
+				{Id: 1, FromAddress: alice, ToAddress: bob, Amount: 12, CategoryId: 1},
+				{Id: 2, FromAddress: alice, ToAddress: carol, Amount: 5},
+			}, 2, nil,
+				[]types.KudosSentTotal{{Address: alice, Total: 17}}, nil,
+				[]types.Category{types.NewCategory(1, "code-review", "", true)},
+				[]types.CategoryBalance{{CategoryId: 1, Address: bob, Balance: 12}}, validLifetimeTotals(), types.DecayState{}, types.Season{}, nil, nil, nil, nil,
+				nil, 0, nil, types.SeasonRolloverState{},
+			),
+		},
+		{
+			name: "duplicate category",
+			genState: types.NewGenesisState(types.DefaultParams(), nil, nil, 0, nil, nil, nil,
+				[]types.Category{types.NewCategory(1, "a", "", true), types.NewCategory(1, "b", "", true)},
+				nil, nil, types.DecayState{}, types.Season{}, nil, nil, nil, nil,
+				nil, 0, nil, types.SeasonRolloverState{},
+			),
+			expectErr: true,
+			errMsg:    "categories[1]: duplicate category id 1",
+		},
+		{
+			name: "category balance in unknown category",
+			genState: types.NewGenesisState(types.DefaultParams(), nil, nil, 0, nil, nil, nil, nil,
+				[]types.CategoryBalance{{CategoryId: 3, Address: bob, Balance: 1}}, nil, types.DecayState{}, types.Season{}, nil, nil, nil, nil,
+				nil, 0, nil, types.SeasonRolloverState{},
+			),
+			expectErr: true,
+			errMsg:    "category_balances[0]: unknown category 3",
+		},
+		{
+			name: "history in unknown category",
+			genState: types.NewGenesisState(types.DefaultParams(), nil, []types.KudosHistory{
+				{Id: 1, FromAddress: alice, ToAddress: bob, Amount: 1, CategoryId: 2},
+			}, 1, nil, nil, nil, nil, nil, nil, types.DecayState{}, types.Season{}, nil, nil, nil, nil, nil, 0, nil, types.SeasonRolloverState{}),
+			expectErr: true,
+			errMsg:    "history[0] (id 1): unknown category 2",
+		},
+		{
+			name: "category balance does not match history",
+			genState: types.NewGenesisState(types.DefaultParams(), validBalances(), []types.KudosHistory{
+				{Id: 1, FromAddress: alice, ToAddress: bob, Amount: 12, CategoryId: 1},
+				{Id: 2, FromAddress: alice, ToAddress: carol, Amount: 5},
+			}, 2, nil,
+				[]types.KudosSentTotal{{Address: alice, Total: 17}}, nil,
+				[]types.Category{types.NewCategory(1, "code-review", "", true)},
+				[]types.CategoryBalance{{CategoryId: 1, Address: bob, Balance: 2}}, validLifetimeTotals(), types.DecayState{}, types.Season{}, nil, nil, nil, nil,
+				nil, 0, nil, types.SeasonRolloverState{},
+			),
+			expectErr: true,
+			errMsg:    "category_balances[0]: address " + bob + " has balance 2 in category 1 but received 12 in history",
+		},
+		{
+			name: "revoked history is excluded from balances",
+			genState: types.NewGenesisState(types.DefaultParams(),
+				[]types.KudosBalance{{Address: bob, Balance: 2}, {Address: carol, Balance: 5}},
+				func() []types.KudosHistory {
+					history := validHistory()
+					history[0].Revoked = true
+					history[0].RevokedAt = 1700000000
+					return history
+				}(), 3, nil,
+				[]types.KudosSentTotal{{Address: alice, Total: 5}, {Address: carol, Total: 2}},
+				nil, nil, nil,
+				[]types.KudosLifetimeTotal{{Address: bob, Total: 2}, {Address: carol, Total: 5}}, types.DecayState{}, types.Season{}, nil, nil, nil, nil,
+				nil, 0, nil, types.SeasonRolloverState{},
+			),
+		},
+		{
+			name: "revoked_at without revoked",
+			genState: types.NewGenesisState(types.DefaultParams(), validBalances(),
+				func() []types.KudosHistory {
+					history := validHistory()
+					history[0].RevokedAt = 1700000000
+					return history
+				}(), 3, nil, validSentTotals(), nil, nil, nil, validLifetimeTotals(), types.DecayState{}, types.Season{}, nil, nil, nil, nil,
+				nil, 0, nil, types.SeasonRolloverState{},
+			),
+			expectErr: true,
+			errMsg:    "history[0] (id 1): revoked_at set on an entry that is not revoked",
+		},
+		{
+			name: "invalid history tip",
+			genState: types.NewGenesisState(types.DefaultParams(), validBalances(),
+				func() []types.KudosHistory {
+					history := validHistory()
+					history[0].Tip = sdk.Coins{sdk.Coin{Denom: "stake", Amount: math.NewInt(-1)}}
+					return history
+				}(), 3, nil, validSentTotals(), nil, nil, nil, validLifetimeTotals(), types.DecayState{}, types.Season{}, nil, nil, nil, nil,
+				nil, 0, nil, types.SeasonRolloverState{},
+			),
+			expectErr: true,
+			errMsg:    "history[0] (id 1): invalid tip",
+		},
+		{
+			name: "valid seasons",
+			genState: types.NewGenesisState(types.DefaultParams(), nil, nil, 0, nil, nil, nil, nil, nil, nil, types.DecayState{},
+				types.Season{Id: 2, StartTime: 200, EndTime: 300},
+				[]types.Season{{Id: 1, StartTime: 100, EndTime: 200}},
+				[]types.SeasonStanding{{SeasonId: 1, Rank: 1, Address: bob, Balance: 12}, {SeasonId: 1, Rank: 2, Address: carol, Balance: 5}}, nil, nil,
+				nil, 0, nil, types.SeasonRolloverState{},
+			),
+		},
+		{
+			name: "ended season not before current season",
+			genState: types.NewGenesisState(types.DefaultParams(), nil, nil, 0, nil, nil, nil, nil, nil, nil, types.DecayState{},
+				types.Season{Id: 1, StartTime: 200, EndTime: 300},
+				[]types.Season{{Id: 1, StartTime: 100, EndTime: 200}},
+				nil, nil, nil,
+				nil, 0, nil, types.SeasonRolloverState{},
+			),
+			expectErr: true,
+			errMsg:    "seasons[0]: ended season 1 is not before the current season 1",
+		},
+		{
+			name: "standing of unknown season",
+			genState: types.NewGenesisState(types.DefaultParams(), nil, nil, 0, nil, nil, nil, nil, nil, nil, types.DecayState{},
+				types.Season{Id: 1, StartTime: 100, EndTime: 200},
+				nil,
+				[]types.SeasonStanding{{SeasonId: 1, Rank: 1, Address: bob, Balance: 12}}, nil, nil,
+				nil, 0, nil, types.SeasonRolloverState{},
+			),
+			expectErr: true,
+			errMsg:    "season_standings[0]: unknown ended season 1",
+		},
+		{
+			name: "duplicate season standing rank",
+			genState: types.NewGenesisState(types.DefaultParams(), nil, nil, 0, nil, nil, nil, nil, nil, nil, types.DecayState{},
+				types.Season{},
+				[]types.Season{{Id: 1, StartTime: 100, EndTime: 200}},
+				[]types.SeasonStanding{{SeasonId: 1, Rank: 1, Address: bob, Balance: 12}, {SeasonId: 1, Rank: 1, Address: carol, Balance: 5}}, nil, nil,
+				nil, 0, nil, types.SeasonRolloverState{},
+			),
+			expectErr: true,
+			errMsg:    "season_standings[1]: duplicate rank 1 in season 1",
+		},
+		{
+			name: "valid season rollover",
+			genState: types.NewGenesisState(types.DefaultParams(), nil, nil, 0, nil, nil, nil, nil, nil, nil, types.DecayState{},
+				types.Season{},
+				[]types.Season{{Id: 1, StartTime: 100, EndTime: 200}},
+				[]types.SeasonStanding{{SeasonId: 1, Rank: 1, Address: bob, Balance: 12}}, nil, nil,
+				nil, 0, nil, types.SeasonRolloverState{SeasonId: 1, Archived: 1},
+			),
+			expectErr: false,
+		},
+		{
+			name: "season rollover of unknown season",
+			genState: types.NewGenesisState(types.DefaultParams(), nil, nil, 0, nil, nil, nil, nil, nil, nil, types.DecayState{},
+				types.Season{}, nil, nil, nil, nil,
+				nil, 0, nil, types.SeasonRolloverState{SeasonId: 1},
+			),
+			expectErr: true,
+			errMsg:    "season_rollover: unknown ended season 1",
+		},
+		{
+			name: "season rollover while a season runs",
+			genState: types.NewGenesisState(types.DefaultParams(), nil, nil, 0, nil, nil, nil, nil, nil, nil, types.DecayState{},
+				types.Season{Id: 2, StartTime: 200, EndTime: 300},
+				[]types.Season{{Id: 1, StartTime: 100, EndTime: 200}}, nil, nil, nil,
+				nil, 0, nil, types.SeasonRolloverState{SeasonId: 1},
+			),
+			expectErr: true,
+			errMsg:    "season_rollover: season 1 is still being archived while season 2 runs",
+		},
+		{
+			name: "season rollover archived count mismatch",
+			genState: types.NewGenesisState(types.DefaultParams(), nil, nil, 0, nil, nil, nil, nil, nil, nil, types.DecayState{},
+				types.Season{},
+				[]types.Season{{Id: 1, StartTime: 100, EndTime: 200}},
+				[]types.SeasonStanding{{SeasonId: 1, Rank: 1, Address: bob, Balance: 12}}, nil, nil,
+				nil, 0, nil, types.SeasonRolloverState{SeasonId: 1, Archived: 2},
+			),
+			expectErr: true,
+			errMsg:    "season_rollover: season 1 has 1 standings, expected 2",
+		},
+		{
+			name: "archived standings without a season rollover",
+			genState: types.NewGenesisState(types.DefaultParams(), nil, nil, 0, nil, nil, nil, nil, nil, nil, types.DecayState{},
+				types.Season{}, nil, nil, nil, nil,
+				nil, 0, nil, types.SeasonRolloverState{Archived: 1},
+			),
+			expectErr: true,
+			errMsg:    "season_rollover: 1 archived standings without a season",
+		},
+		{
+			name: "valid budget allowances and giveable balances",
+			genState: types.NewGenesisState(types.DefaultParams(), nil, nil, 0, nil, nil, nil, nil, nil, nil, types.DecayState{}, types.Season{}, nil, nil,
+				[]types.BudgetAllowance{{Address: alice, Allowance: 40}},
+				[]types.GiveableBalance{{Address: alice, Remaining: 12, Epoch: 3}},
+				nil, 0, nil, types.SeasonRolloverState{},
+			),
+			expectErr: false,
+		},
+		{
+			name: "duplicate budget allowance",
+			genState: types.NewGenesisState(types.DefaultParams(), nil, nil, 0, nil, nil, nil, nil, nil, nil, types.DecayState{}, types.Season{}, nil, nil,
+				[]types.BudgetAllowance{{Address: alice, Allowance: 40}, {Address: alice, Allowance: 10}}, nil,
+				nil, 0, nil, types.SeasonRolloverState{},
+			),
+			expectErr: true,
+			errMsg:    "budget_allowances[1]: duplicate allowance for address",
+		},
+		{
+			name: "invalid giveable balance address",
+			genState: types.NewGenesisState(types.DefaultParams(), nil, nil, 0, nil, nil, nil, nil, nil, nil, types.DecayState{}, types.Season{}, nil, nil,
+				nil, []types.GiveableBalance{{Address: "invalid", Remaining: 12}},
+				nil, 0, nil, types.SeasonRolloverState{},
+			),
+			expectErr: true,
+			errMsg:    "giveable_balances[0]: invalid address",
+		},
+		{
+			name: "valid redemptions",
+			genState: types.NewGenesisState(types.DefaultParams(), nil, nil, 0, nil, nil, nil, nil, nil, nil, types.DecayState{}, types.Season{}, nil, nil, nil, nil,
+				[]types.Redemption{{Id: 1, Address: alice, Amount: 3, Payout: sdk.NewInt64Coin("stake", 30)}}, 1,
+				[]types.RedeemUsage{{Address: alice, Redeemed: 3, Epoch: 7}}, types.SeasonRolloverState{},
+			),
+			expectErr: false,
+		},
+		{
+			name: "redemption counter below highest id",
+			genState: types.NewGenesisState(types.DefaultParams(), nil, nil, 0, nil, nil, nil, nil, nil, nil, types.DecayState{}, types.Season{}, nil, nil, nil, nil,
+				[]types.Redemption{{Id: 2, Address: alice, Amount: 3, Payout: sdk.NewInt64Coin("stake", 30)}}, 1, nil, types.SeasonRolloverState{},
+			),
+			expectErr: true,
+			errMsg:    "redemption counter 1 is lower than the highest redemption id 2",
+		},
+		{
+			name: "invalid redemption payout",
+			genState: types.NewGenesisState(types.DefaultParams(), nil, nil, 0, nil, nil, nil, nil, nil, nil, types.DecayState{}, types.Season{}, nil, nil, nil, nil,
+				[]types.Redemption{{Id: 1, Address: alice, Amount: 3}}, 1, nil, types.SeasonRolloverState{},
+			),
+			expectErr: true,
+			errMsg:    "redemptions[0] (id 1): invalid payout",
+		},
+		{
+			name: "duplicate redeem usage",
+			genState: types.NewGenesisState(types.DefaultParams(), nil, nil, 0, nil, nil, nil, nil, nil, nil, types.DecayState{}, types.Season{}, nil, nil, nil, nil,
+				nil, 0, []types.RedeemUsage{{Address: alice, Redeemed: 3}, {Address: alice, Redeemed: 1}}, types.SeasonRolloverState{},
+			),
+			expectErr: true,
+			errMsg:    "redeem_usages[1]: duplicate redeem usage for address",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.genState.Validate()
+			if tt.expectErr {
+				require.ErrorIs(t, err, types.ErrInvalidGenesis)
+				require.Contains(t, err.Error(), tt.errMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
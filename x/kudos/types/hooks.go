@@ -0,0 +1,46 @@
+package types
+
+import (
+	"context"
+)
+
+var _ KudosHooks = MultiKudosHooks{}
+
+// MultiKudosHooks combines multiple kudos hooks; all hooks are called in order and the first
+// error stops the chain
+type MultiKudosHooks []KudosHooks
+
+// NewMultiKudosHooks returns hooks that call each of hooks in order
+func NewMultiKudosHooks(hooks ...KudosHooks) MultiKudosHooks {
+	return hooks
+}
+
+// BeforeKudosSent implements KudosHooks
+func (h MultiKudosHooks) BeforeKudosSent(ctx context.Context, fromAddress, toAddress string, amount, categoryID uint64) error {
+	for i := range h {
+		if err := h[i].BeforeKudosSent(ctx, fromAddress, toAddress, amount, categoryID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterKudosSent implements KudosHooks
+func (h MultiKudosHooks) AfterKudosSent(ctx context.Context, history KudosHistory) error {
+	for i := range h {
+		if err := h[i].AfterKudosSent(ctx, history); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// AfterKudosRevoked implements KudosHooks
+func (h MultiKudosHooks) AfterKudosRevoked(ctx context.Context, history KudosHistory) error {
+	for i := range h {
+		if err := h[i].AfterKudosRevoked(ctx, history); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package types_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+// recordingHooks appends its name to calls on every hook and returns err from each of them
+type recordingHooks struct {
+	name  string
+	calls *[]string
+	err   error
+}
+
+func (h recordingHooks) BeforeKudosSent(context.Context, string, string, uint64, uint64) error {
+	*h.calls = append(*h.calls, h.name)
+	return h.err
+}
+
+func (h recordingHooks) AfterKudosSent(context.Context, types.KudosHistory) error {
+	*h.calls = append(*h.calls, h.name)
+	return h.err
+}
+
+func (h recordingHooks) AfterKudosRevoked(context.Context, types.KudosHistory) error {
+	*h.calls = append(*h.calls, h.name)
+	return h.err
+}
+
+func TestMultiKudosHooks(t *testing.T) {
+	ctx := context.Background()
+	veto := errors.New("veto")
+
+	var calls []string
+	hooks := types.NewMultiKudosHooks(recordingHooks{name: "a", calls: &calls}, recordingHooks{name: "b", calls: &calls})
+	require.NoError(t, hooks.BeforeKudosSent(ctx, "from", "to", 1, 0))
+	require.NoError(t, hooks.AfterKudosSent(ctx, types.KudosHistory{}))
+	require.NoError(t, hooks.AfterKudosRevoked(ctx, types.KudosHistory{}))
+	require.Equal(t, []string{"a", "b", "a", "b", "a", "b"}, calls)
+
+	// The first error stops the chain
+	calls = nil
+	hooks = types.NewMultiKudosHooks(recordingHooks{name: "a", calls: &calls, err: veto}, recordingHooks{name: "b", calls: &calls})
+	require.ErrorIs(t, hooks.BeforeKudosSent(ctx, "from", "to", 1, 0), veto)
+	require.ErrorIs(t, hooks.AfterKudosSent(ctx, types.KudosHistory{}), veto)
+	require.ErrorIs(t, hooks.AfterKudosRevoked(ctx, types.KudosHistory{}), veto)
+	require.Equal(t, []string{"a", "a", "a"}, calls)
+
+	// No hooks is a no-op
+	require.NoError(t, types.MultiKudosHooks{}.BeforeKudosSent(ctx, "from", "to", 1, 0))
+}
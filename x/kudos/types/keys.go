@@ -7,17 +7,14 @@ const (
 	// StoreKey is the string store representation
 	StoreKey = ModuleName
 
+	// TStoreKey is the string transient store representation
+	TStoreKey = "transient_" + ModuleName
+
 	// RouterKey is the msg router key for the kudos module
 	RouterKey = ModuleName
 
 	// QuerierRoute is the querier route for the kudos module
 	QuerierRoute = ModuleName
-
-	// DefaultDailyLimit defines how many kudos a user can send within a 24h window
-	DefaultDailyLimit uint64 = 100
-
-	// DailyQuotaWindowSeconds defines how long a quota window lasts (24 hours)
-	DailyQuotaWindowSeconds = 60 * 60 * 24
 )
 
 var (
@@ -32,6 +29,79 @@ var (
 
 	// DailySentPrefix is the prefix for daily sent counters
 	DailySentPrefix = []byte{0x04}
+
+	// ParamsKey is the key for the module parameters
+	ParamsKey = []byte{0x05}
+
+	// HistoryBySenderPrefix is the prefix for the sender -> history ID index
+	HistoryBySenderPrefix = []byte{0x06}
+
+	// HistoryByRecipientPrefix is the prefix for the recipient -> history ID index
+	HistoryByRecipientPrefix = []byte{0x07}
+
+	// TxMsgIndexKey tracks the next kudos message index of the transaction being executed. It
+	// is kept in the transient store, so it never becomes part of the consensus state.
+	TxMsgIndexKey = []byte{0x08}
+
+	// LeaderboardPrefix is the prefix for the (inverted balance, address) leaderboard index
+	LeaderboardPrefix = []byte{0x09}
+
+	// KudosSentTotalPrefix is the prefix for lifetime total sent keys
+	KudosSentTotalPrefix = []byte{0x0A}
+
+	// GiverLeaderboardPrefix is the prefix for the (inverted total sent, address) giver leaderboard index
+	GiverLeaderboardPrefix = []byte{0x0B}
+
+	// PeriodBucketPrefix is the prefix for per-day (day, address) received kudos buckets
+	PeriodBucketPrefix = []byte{0x0C}
+
+	// CategoryPrefix is the prefix for the category registry
+	CategoryPrefix = []byte{0x0D}
+
+	// CategoryBalancePrefix is the prefix for per-category (category, address) balances
+	CategoryBalancePrefix = []byte{0x0E}
+
+	// CategoryLeaderboardPrefix is the prefix for the per-category (inverted balance, address) leaderboard index
+	CategoryLeaderboardPrefix = []byte{0x0F}
+
+	// HistoryByCategoryPrefix is the prefix for the category -> history ID index
+	HistoryByCategoryPrefix = []byte{0x10}
+
+	// KudosLifetimePrefix is the prefix for lifetime total received keys, which decay never reduces
+	KudosLifetimePrefix = []byte{0x11}
+
+	// DecayStateKey is the key for the state of the running balance decay pass
+	DecayStateKey = []byte{0x12}
+
+	// CurrentSeasonKey is the key for the running season
+	CurrentSeasonKey = []byte{0x13}
+
+	// SeasonPrefix is the prefix for ended seasons
+	SeasonPrefix = []byte{0x14}
+
+	// SeasonStandingPrefix is the prefix for the archived (season, rank) standings of ended seasons
+	SeasonStandingPrefix = []byte{0x15}
+
+	// BudgetAllowancePrefix is the prefix for per-address allowance overrides of budget mode
+	BudgetAllowancePrefix = []byte{0x16}
+
+	// GiveableBalancePrefix is the prefix for the remaining giveable allowance of an address and its epoch
+	GiveableBalancePrefix = []byte{0x17}
+
+	// RedemptionPrefix is the prefix for redemption records
+	RedemptionPrefix = []byte{0x18}
+
+	// RedemptionCounterKey is the key for the global redemption counter
+	RedemptionCounterKey = []byte{0x19}
+
+	// RedemptionByAddressPrefix is the prefix for the address -> redemption ID index
+	RedemptionByAddressPrefix = []byte{0x1A}
+
+	// RedeemUsagePrefix is the prefix for the kudos an address redeemed within a redeem epoch
+	RedeemUsagePrefix = []byte{0x1B}
+
+	// SeasonRolloverKey is the key for the state of the running archival of an ended season
+	SeasonRolloverKey = []byte{0x1C}
 )
 
 // KudosBalanceKey returns the key for a kudos balance
@@ -39,16 +109,183 @@ func KudosBalanceKey(address string) []byte {
 	return append(KudosBalancePrefix, []byte(address)...)
 }
 
+// KudosLifetimeKey returns the key for the lifetime total received by an address
+func KudosLifetimeKey(address string) []byte {
+	return append(KudosLifetimePrefix, []byte(address)...)
+}
+
+// SeasonKey returns the key for an ended season
+func SeasonKey(id uint64) []byte {
+	return append(SeasonPrefix, uint64ToBytes(id)...)
+}
+
+// SeasonStandingPrefixKey returns the prefix for all archived standings of a season
+func SeasonStandingPrefixKey(seasonID uint64) []byte {
+	return append(SeasonStandingPrefix, uint64ToBytes(seasonID)...)
+}
+
+// SeasonStandingKey returns the key for an archived standing, ordered by rank within its season
+func SeasonStandingKey(seasonID, rank uint64) []byte {
+	return append(SeasonStandingPrefixKey(seasonID), uint64ToBytes(rank)...)
+}
+
+// BudgetAllowanceKey returns the key for the per-epoch allowance override of an address
+func BudgetAllowanceKey(address string) []byte {
+	return append(BudgetAllowancePrefix, []byte(address)...)
+}
+
+// GiveableBalanceKey returns the key for the remaining giveable allowance of an address
+func GiveableBalanceKey(address string) []byte {
+	return append(GiveableBalancePrefix, []byte(address)...)
+}
+
+// RedemptionKey returns the key for a redemption record
+func RedemptionKey(id uint64) []byte {
+	return append(RedemptionPrefix, uint64ToBytes(id)...)
+}
+
+// RedemptionByAddressPrefixKey returns the index prefix for all redemptions of an address
+func RedemptionByAddressPrefixKey(address string) []byte {
+	return append(RedemptionByAddressPrefix, lengthPrefixed(address)...)
+}
+
+// RedemptionByAddressKey returns the index key linking an address to a redemption
+func RedemptionByAddressKey(address string, id uint64) []byte {
+	return append(RedemptionByAddressPrefixKey(address), uint64ToBytes(id)...)
+}
+
+// RedeemUsageKey returns the key for the kudos an address redeemed in its last redeem epoch
+func RedeemUsageKey(address string) []byte {
+	return append(RedeemUsagePrefix, []byte(address)...)
+}
+
 // KudosHistoryKey returns the key for a kudos history entry
 func KudosHistoryKey(id uint64) []byte {
+	return append(KudosHistoryPrefix, uint64ToBytes(id)...)
+}
+
+// HistoryBySenderPrefixKey returns the index prefix for all history entries sent by an address
+func HistoryBySenderPrefixKey(address string) []byte {
+	return append(HistoryBySenderPrefix, lengthPrefixed(address)...)
+}
+
+// HistoryBySenderKey returns the index key linking a sender to a history entry
+func HistoryBySenderKey(address string, id uint64) []byte {
+	return append(HistoryBySenderPrefixKey(address), uint64ToBytes(id)...)
+}
+
+// HistoryByRecipientPrefixKey returns the index prefix for all history entries received by an address
+func HistoryByRecipientPrefixKey(address string) []byte {
+	return append(HistoryByRecipientPrefix, lengthPrefixed(address)...)
+}
+
+// HistoryByRecipientKey returns the index key linking a recipient to a history entry
+func HistoryByRecipientKey(address string, id uint64) []byte {
+	return append(HistoryByRecipientPrefixKey(address), uint64ToBytes(id)...)
+}
+
+// DailySentKey returns the key for storing daily sent data for an address
+func DailySentKey(address string) []byte {
+	return append(DailySentPrefix, []byte(address)...)
+}
+
+// LeaderboardKey returns the leaderboard index key for an address with the given balance.
+// The balance is inverted so that ascending key order lists the highest balances first
+// and equal balances are ordered by address.
+func LeaderboardKey(address string, balance uint64) []byte {
+	return rankedKey(LeaderboardPrefix, address, balance)
+}
+
+// KudosSentTotalKey returns the key for the lifetime total sent by an address
+func KudosSentTotalKey(address string) []byte {
+	return append(KudosSentTotalPrefix, []byte(address)...)
+}
+
+// GiverLeaderboardKey returns the giver leaderboard index key for an address with the given total sent
+func GiverLeaderboardKey(address string, totalSent uint64) []byte {
+	return rankedKey(GiverLeaderboardPrefix, address, totalSent)
+}
+
+// PeriodBucketDayPrefix returns the prefix for all buckets of a single day
+func PeriodBucketDayPrefix(day uint64) []byte {
+	return append(PeriodBucketPrefix, uint64ToBytes(day)...)
+}
+
+// PeriodBucketKey returns the key for the kudos an address received on a given day
+func PeriodBucketKey(day uint64, address string) []byte {
+	return append(PeriodBucketDayPrefix(day), []byte(address)...)
+}
+
+// ParsePeriodBucketKey splits a period bucket key (without prefix) into day and address
+func ParsePeriodBucketKey(key []byte) (uint64, string) {
+	var day uint64
+	for i := 0; i < 8; i++ {
+		day = day<<8 | uint64(key[i])
+	}
+	return day, string(key[8:])
+}
+
+// CategoryKey returns the registry key for a category
+func CategoryKey(id uint64) []byte {
+	return append(CategoryPrefix, uint64ToBytes(id)...)
+}
+
+// CategoryBalancePrefixKey returns the prefix for all balances within a category
+func CategoryBalancePrefixKey(categoryID uint64) []byte {
+	return append(CategoryBalancePrefix, uint64ToBytes(categoryID)...)
+}
+
+// CategoryBalanceKey returns the key for the kudos an address received within a category
+func CategoryBalanceKey(categoryID uint64, address string) []byte {
+	return append(CategoryBalancePrefixKey(categoryID), []byte(address)...)
+}
+
+// CategoryLeaderboardPrefixKey returns the leaderboard index prefix of a single category
+func CategoryLeaderboardPrefixKey(categoryID uint64) []byte {
+	return append(CategoryLeaderboardPrefix, uint64ToBytes(categoryID)...)
+}
+
+// CategoryLeaderboardKey returns the category leaderboard index key for an address with the given balance
+func CategoryLeaderboardKey(categoryID uint64, address string, balance uint64) []byte {
+	return rankedKey(CategoryLeaderboardPrefixKey(categoryID), address, balance)
+}
+
+// HistoryByCategoryPrefixKey returns the index prefix for all history entries of a category
+func HistoryByCategoryPrefixKey(categoryID uint64) []byte {
+	return append(HistoryByCategoryPrefix, uint64ToBytes(categoryID)...)
+}
+
+// HistoryByCategoryKey returns the index key linking a category to a history entry
+func HistoryByCategoryKey(categoryID, id uint64) []byte {
+	return append(HistoryByCategoryPrefixKey(categoryID), uint64ToBytes(id)...)
+}
+
+// ParseLeaderboardKey splits a leaderboard or giver leaderboard index key (without prefix)
+// into address and amount
+func ParseLeaderboardKey(key []byte) (string, uint64) {
+	var inverted uint64
+	for i := 0; i < 8; i++ {
+		inverted = inverted<<8 | uint64(key[i])
+	}
+	return string(key[8:]), ^inverted
+}
+
+// rankedKey builds a descending-order index key of an inverted amount followed by the address
+func rankedKey(prefix []byte, address string, amount uint64) []byte {
+	key := append(prefix, uint64ToBytes(^amount)...)
+	return append(key, []byte(address)...)
+}
+
+// uint64ToBytes encodes an ID as big endian so keys sort in numeric order
+func uint64ToBytes(id uint64) []byte {
 	bz := make([]byte, 8)
 	for i := 0; i < 8; i++ {
 		bz[i] = byte(id >> (8 * (7 - i)))
 	}
-	return append(KudosHistoryPrefix, bz...)
+	return bz
 }
 
-// DailySentKey returns the key for storing daily sent data for an address
-func DailySentKey(address string) []byte {
-	return append(DailySentPrefix, []byte(address)...)
+// lengthPrefixed prefixes an address with its length so one address is never a key prefix of another
+func lengthPrefixed(address string) []byte {
+	return append([]byte{byte(len(address))}, []byte(address)...)
 }
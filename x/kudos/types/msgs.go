@@ -1,24 +1,36 @@
 package types
 
 import (
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
-var _ sdk.Msg = &MsgSendKudos{}
+var (
+	_ sdk.Msg = &MsgSendKudos{}
+	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgMultiSendKudos{}
+	_ sdk.Msg = &MsgSetCategory{}
+	_ sdk.Msg = &MsgRevokeKudos{}
+	_ sdk.Msg = &MsgSetBudgetAllowance{}
+	_ sdk.Msg = &MsgFundRewardPool{}
+	_ sdk.Msg = &MsgRedeemKudos{}
+)
+
+// MaxMultiSendOutputs bounds how many recipients a single MsgMultiSendKudos may carry
+const MaxMultiSendOutputs = 100
 
 // ValidateBasic performs stateless validation on MsgSendKudos
 func (msg *MsgSendKudos) ValidateBasic() error {
 	// Validate from address
 	_, err := sdk.AccAddressFromBech32(msg.FromAddress)
 	if err != nil {
-		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid from address: %s", err)
+		return errorsmod.Wrapf(ErrInvalidAddress, "invalid from address: %s", err)
 	}
 
 	// Validate to address
 	_, err = sdk.AccAddressFromBech32(msg.ToAddress)
 	if err != nil {
-		return sdkerrors.Wrapf(ErrInvalidAddress, "invalid to address: %s", err)
+		return errorsmod.Wrapf(ErrInvalidAddress, "invalid to address: %s", err)
 	}
 
 	// Cannot send to yourself
@@ -31,9 +43,15 @@ func (msg *MsgSendKudos) ValidateBasic() error {
 		return ErrInvalidAmount
 	}
 
-	// Comment must not exceed 140 characters
-	if len(msg.Comment) > 140 {
-		return ErrCommentTooLong
+	// Comment length is bounded by module params and checked by the keeper;
+	// here we only reject comments no params could ever allow
+	if len(msg.Comment) > int(MaxCommentLengthCap) {
+		return errorsmod.Wrapf(ErrCommentTooLong, "comment length %d exceeds cap %d", len(msg.Comment), MaxCommentLengthCap)
+	}
+
+	// The tip is optional; allowed denoms are checked by the keeper against params
+	if !msg.Tip.IsValid() {
+		return errorsmod.Wrapf(ErrInvalidTip, "%s", msg.Tip)
 	}
 
 	return nil
@@ -47,3 +65,188 @@ func (msg *MsgSendKudos) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{fromAddress}
 }
+
+// ValidateBasic performs stateless validation on MsgUpdateParams
+func (msg *MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidParams, err.Error())
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgUpdateParams
+func (msg *MsgUpdateParams) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic performs stateless validation on MsgMultiSendKudos
+func (msg *MsgMultiSendKudos) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddress, "invalid from address: %s", err)
+	}
+
+	if len(msg.Outputs) == 0 {
+		return errorsmod.Wrap(ErrInvalidRequest, "at least one output is required")
+	}
+	if len(msg.Outputs) > MaxMultiSendOutputs {
+		return errorsmod.Wrapf(ErrInvalidRequest, "%d outputs exceed the maximum of %d", len(msg.Outputs), MaxMultiSendOutputs)
+	}
+
+	var total uint64
+	seen := make(map[string]bool, len(msg.Outputs))
+	for i, output := range msg.Outputs {
+		if _, err := sdk.AccAddressFromBech32(output.ToAddress); err != nil {
+			return errorsmod.Wrapf(ErrInvalidAddress, "outputs[%d]: invalid to address: %s", i, err)
+		}
+		if output.ToAddress == msg.FromAddress {
+			return errorsmod.Wrapf(ErrSameAddress, "outputs[%d]", i)
+		}
+		if seen[output.ToAddress] {
+			return errorsmod.Wrapf(ErrInvalidRequest, "outputs[%d]: duplicate recipient %s", i, output.ToAddress)
+		}
+		seen[output.ToAddress] = true
+
+		if output.Amount == 0 {
+			return errorsmod.Wrapf(ErrInvalidAmount, "outputs[%d]", i)
+		}
+		if total+output.Amount < total {
+			return errorsmod.Wrapf(ErrInvalidAmount, "outputs[%d]: total amount overflows", i)
+		}
+		total += output.Amount
+
+		if len(output.Comment) > int(MaxCommentLengthCap) {
+			return errorsmod.Wrapf(ErrCommentTooLong, "outputs[%d]: comment length %d exceeds cap %d", i, len(output.Comment), MaxCommentLengthCap)
+		}
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgMultiSendKudos
+func (msg *MsgMultiSendKudos) GetSigners() []sdk.AccAddress {
+	fromAddress, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{fromAddress}
+}
+
+// ValidateBasic performs stateless validation on MsgSetCategory
+func (msg *MsgSetCategory) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	return msg.Category.Validate()
+}
+
+// GetSigners returns the expected signers for MsgSetCategory
+func (msg *MsgSetCategory) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic performs stateless validation on MsgRevokeKudos
+func (msg *MsgRevokeKudos) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.FromAddress); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddress, "invalid from address: %s", err)
+	}
+
+	if msg.HistoryId == 0 {
+		return errorsmod.Wrap(ErrInvalidRequest, "history id must be greater than 0")
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgRevokeKudos
+func (msg *MsgRevokeKudos) GetSigners() []sdk.AccAddress {
+	fromAddress, err := sdk.AccAddressFromBech32(msg.FromAddress)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{fromAddress}
+}
+
+// ValidateBasic performs stateless validation on MsgSetBudgetAllowance
+func (msg *MsgSetBudgetAllowance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddress, "invalid authority address: %s", err)
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddress, "invalid address: %s", err)
+	}
+
+	if msg.Remove && msg.Allowance != 0 {
+		return errorsmod.Wrap(ErrInvalidRequest, "allowance must be 0 when removing an override")
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgSetBudgetAllowance
+func (msg *MsgSetBudgetAllowance) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{authority}
+}
+
+// ValidateBasic performs stateless validation on MsgFundRewardPool
+func (msg *MsgFundRewardPool) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Depositor); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddress, "invalid depositor address: %s", err)
+	}
+
+	// The accepted denom is checked by the keeper against params
+	if !msg.Amount.IsValid() || msg.Amount.Empty() {
+		return errorsmod.Wrapf(ErrInvalidAmount, "invalid funding amount %s", msg.Amount)
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgFundRewardPool
+func (msg *MsgFundRewardPool) GetSigners() []sdk.AccAddress {
+	depositor, err := sdk.AccAddressFromBech32(msg.Depositor)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{depositor}
+}
+
+// ValidateBasic performs stateless validation on MsgRedeemKudos
+func (msg *MsgRedeemKudos) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return errorsmod.Wrapf(ErrInvalidAddress, "invalid address: %s", err)
+	}
+
+	if msg.Amount == 0 {
+		return ErrInvalidAmount
+	}
+
+	return nil
+}
+
+// GetSigners returns the expected signers for MsgRedeemKudos
+func (msg *MsgRedeemKudos) GetSigners() []sdk.AccAddress {
+	address, err := sdk.AccAddressFromBech32(msg.Address)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{address}
+}
@@ -1,13 +1,22 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
 )
 
+var (
+	fromAddr = sdk.AccAddress([]byte("from________________")).String()
+	toAddr   = sdk.AccAddress([]byte("to__________________")).String()
+	sameAddr = sdk.AccAddress([]byte("same________________")).String()
+)
+
 func TestMsgSendKudos_ValidateBasic(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -18,18 +27,39 @@ func TestMsgSendKudos_ValidateBasic(t *testing.T) {
 		{
 			name: "valid message",
 			msg: types.MsgSendKudos{
-				FromAddress: "cosmos1from",
-				ToAddress:   "cosmos1to",
+				FromAddress: fromAddr,
+				ToAddress:   toAddr,
 				Amount:      100,
 				Comment:     "Great work!",
 			},
 			expectErr: false,
 		},
+		{
+			name: "valid message with tip",
+			msg: types.MsgSendKudos{
+				FromAddress: fromAddr,
+				ToAddress:   toAddr,
+				Amount:      100,
+				Tip:         sdk.NewCoins(sdk.NewInt64Coin("stake", 5)),
+			},
+			expectErr: false,
+		},
+		{
+			name: "invalid tip",
+			msg: types.MsgSendKudos{
+				FromAddress: fromAddr,
+				ToAddress:   toAddr,
+				Amount:      100,
+				Tip:         sdk.Coins{sdk.Coin{Denom: "stake", Amount: math.ZeroInt()}},
+			},
+			expectErr: true,
+			errType:   types.ErrInvalidTip,
+		},
 		{
 			name: "invalid from address",
 			msg: types.MsgSendKudos{
 				FromAddress: "invalid",
-				ToAddress:   "cosmos1to",
+				ToAddress:   toAddr,
 				Amount:      100,
 				Comment:     "Great work!",
 			},
@@ -39,7 +69,7 @@ func TestMsgSendKudos_ValidateBasic(t *testing.T) {
 		{
 			name: "invalid to address",
 			msg: types.MsgSendKudos{
-				FromAddress: "cosmos1from",
+				FromAddress: fromAddr,
 				ToAddress:   "invalid",
 				Amount:      100,
 				Comment:     "Great work!",
@@ -50,8 +80,8 @@ func TestMsgSendKudos_ValidateBasic(t *testing.T) {
 		{
 			name: "same address",
 			msg: types.MsgSendKudos{
-				FromAddress: "cosmos1same",
-				ToAddress:   "cosmos1same",
+				FromAddress: sameAddr,
+				ToAddress:   sameAddr,
 				Amount:      100,
 				Comment:     "Self kudos",
 			},
@@ -61,8 +91,8 @@ func TestMsgSendKudos_ValidateBasic(t *testing.T) {
 		{
 			name: "zero amount",
 			msg: types.MsgSendKudos{
-				FromAddress: "cosmos1from",
-				ToAddress:   "cosmos1to",
+				FromAddress: fromAddr,
+				ToAddress:   toAddr,
 				Amount:      0,
 				Comment:     "Zero kudos",
 			},
@@ -70,13 +100,23 @@ func TestMsgSendKudos_ValidateBasic(t *testing.T) {
 			errType:   types.ErrInvalidAmount,
 		},
 		{
-			name: "comment too long",
+			name: "comment longer than default is left to params",
 			msg: types.MsgSendKudos{
-				FromAddress: "cosmos1from",
-				ToAddress:   "cosmos1to",
+				FromAddress: fromAddr,
+				ToAddress:   toAddr,
 				Amount:      100,
 				Comment:     "This is a very long comment that exceeds the maximum allowed length of 140 characters. It should fail validation because it's way too long for a kudos comment.",
 			},
+			expectErr: false,
+		},
+		{
+			name: "comment too long",
+			msg: types.MsgSendKudos{
+				FromAddress: fromAddr,
+				ToAddress:   toAddr,
+				Amount:      100,
+				Comment:     strings.Repeat("a", int(types.MaxCommentLengthCap)+1),
+			},
 			expectErr: true,
 			errType:   types.ErrCommentTooLong,
 		},
@@ -96,3 +136,465 @@ func TestMsgSendKudos_ValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgUpdateParams_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name      string
+		msg       types.MsgUpdateParams
+		expectErr bool
+		errType   error
+	}{
+		{
+			name: "valid message",
+			msg: types.MsgUpdateParams{
+				Authority: fromAddr,
+				Params:    types.DefaultParams(),
+			},
+			expectErr: false,
+		},
+		{
+			name: "invalid authority",
+			msg: types.MsgUpdateParams{
+				Authority: "invalid",
+				Params:    types.DefaultParams(),
+			},
+			expectErr: true,
+			errType:   types.ErrInvalidAddress,
+		},
+		{
+			name: "zero quota window",
+			msg: types.MsgUpdateParams{
+				Authority: fromAddr,
+				Params:    types.NewParams(100, 0, 140, 10, 3600, 0, 0, 0, 0, 100, false, 0, 0, 0, "", nil, "", 0, 0, 0),
+			},
+			expectErr: true,
+			errType:   types.ErrInvalidParams,
+		},
+		{
+			name: "comment length above cap",
+			msg: types.MsgUpdateParams{
+				Authority: fromAddr,
+				Params:    types.NewParams(100, 3600, types.MaxCommentLengthCap+1, 10, 3600, 0, 0, 0, 0, 100, false, 0, 0, 0, "", nil, "", 0, 0, 0),
+			},
+			expectErr: true,
+			errType:   types.ErrInvalidParams,
+		},
+		{
+			name: "decay percent above 100",
+			msg: types.MsgUpdateParams{
+				Authority: fromAddr,
+				Params:    types.NewParams(100, 3600, 140, 10, 3600, 101, 3600, 10, 0, 100, false, 0, 0, 0, "", nil, "", 0, 0, 0),
+			},
+			expectErr: true,
+			errType:   types.ErrInvalidParams,
+		},
+		{
+			name: "decay enabled without epoch",
+			msg: types.MsgUpdateParams{
+				Authority: fromAddr,
+				Params:    types.NewParams(100, 3600, 140, 10, 3600, 10, 0, 10, 0, 100, false, 0, 0, 0, "", nil, "", 0, 0, 0),
+			},
+			expectErr: true,
+			errType:   types.ErrInvalidParams,
+		},
+		{
+			name: "zero season accounts per block",
+			msg: types.MsgUpdateParams{
+				Authority: fromAddr,
+				Params:    types.NewParams(100, 3600, 140, 10, 3600, 0, 0, 0, 3600, 0, false, 0, 0, 0, "", nil, "", 0, 0, 0),
+			},
+			expectErr: true,
+			errType:   types.ErrInvalidParams,
+		},
+		{
+			name: "budget enabled without epoch",
+			msg: types.MsgUpdateParams{
+				Authority: fromAddr,
+				Params:    types.NewParams(100, 3600, 140, 10, 3600, 0, 0, 0, 0, 100, true, 20, 0, 0, "", nil, "", 0, 0, 0),
+			},
+			expectErr: true,
+			errType:   types.ErrInvalidParams,
+		},
+		{
+			name: "invalid allowed tip denom",
+			msg: types.MsgUpdateParams{
+				Authority: fromAddr,
+				Params:    types.NewParams(100, 3600, 140, 10, 3600, 0, 0, 0, 0, 100, false, 0, 0, 0, "", []string{"stake", "1nvalid"}, "", 0, 0, 0),
+			},
+			expectErr: true,
+			errType:   types.ErrInvalidParams,
+		},
+		{
+			name: "duplicate allowed tip denom",
+			msg: types.MsgUpdateParams{
+				Authority: fromAddr,
+				Params:    types.NewParams(100, 3600, 140, 10, 3600, 0, 0, 0, 0, 100, false, 0, 0, 0, "", []string{"stake", "stake"}, "", 0, 0, 0),
+			},
+			expectErr: true,
+			errType:   types.ErrInvalidParams,
+		},
+		{
+			name: "invalid budget admin",
+			msg: types.MsgUpdateParams{
+				Authority: fromAddr,
+				Params:    types.NewParams(100, 3600, 140, 10, 3600, 0, 0, 0, 0, 100, false, 0, 0, 0, "invalid", nil, "", 0, 0, 0),
+			},
+			expectErr: true,
+			errType:   types.ErrInvalidParams,
+		},
+		{
+			name: "redemption without rate",
+			msg: types.MsgUpdateParams{
+				Authority: fromAddr,
+				Params:    types.NewParams(100, 3600, 140, 10, 3600, 0, 0, 0, 0, 100, false, 0, 0, 0, "", nil, "stake", 0, 0, 0),
+			},
+			expectErr: true,
+			errType:   types.ErrInvalidParams,
+		},
+		{
+			name: "redemption cap without epoch",
+			msg: types.MsgUpdateParams{
+				Authority: fromAddr,
+				Params:    types.NewParams(100, 3600, 140, 10, 3600, 0, 0, 0, 0, 100, false, 0, 0, 0, "", nil, "stake", 10, 0, 50),
+			},
+			expectErr: true,
+			errType:   types.ErrInvalidParams,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.expectErr {
+				require.Error(t, err)
+				if tt.errType != nil {
+					require.ErrorIs(t, err, tt.errType)
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgMultiSendKudos_ValidateBasic(t *testing.T) {
+	otherAddr := sdk.AccAddress([]byte("other_______________")).String()
+
+	tests := []struct {
+		name      string
+		msg       types.MsgMultiSendKudos
+		expectErr bool
+		errType   error
+	}{
+		{
+			name: "valid message",
+			msg: types.MsgMultiSendKudos{
+				FromAddress: fromAddr,
+				Outputs: []types.KudosOutput{
+					{ToAddress: toAddr, Amount: 5, Comment: "Great retro"},
+					{ToAddress: otherAddr, Amount: 3},
+				},
+			},
+			expectErr: false,
+		},
+		{
+			name:      "no outputs",
+			msg:       types.MsgMultiSendKudos{FromAddress: fromAddr},
+			expectErr: true,
+			errType:   types.ErrInvalidRequest,
+		},
+		{
+			name: "invalid output address",
+			msg: types.MsgMultiSendKudos{
+				FromAddress: fromAddr,
+				Outputs:     []types.KudosOutput{{ToAddress: "invalid", Amount: 1}},
+			},
+			expectErr: true,
+			errType:   types.ErrInvalidAddress,
+		},
+		{
+			name: "output to sender",
+			msg: types.MsgMultiSendKudos{
+				FromAddress: fromAddr,
+				Outputs:     []types.KudosOutput{{ToAddress: fromAddr, Amount: 1}},
+			},
+			expectErr: true,
+			errType:   types.ErrSameAddress,
+		},
+		{
+			name: "duplicate recipient",
+			msg: types.MsgMultiSendKudos{
+				FromAddress: fromAddr,
+				Outputs:     []types.KudosOutput{{ToAddress: toAddr, Amount: 1}, {ToAddress: toAddr, Amount: 2}},
+			},
+			expectErr: true,
+			errType:   types.ErrInvalidRequest,
+		},
+		{
+			name: "zero amount output",
+			msg: types.MsgMultiSendKudos{
+				FromAddress: fromAddr,
+				Outputs:     []types.KudosOutput{{ToAddress: toAddr, Amount: 0}},
+			},
+			expectErr: true,
+			errType:   types.ErrInvalidAmount,
+		},
+		{
+			name: "total overflows",
+			msg: types.MsgMultiSendKudos{
+				FromAddress: fromAddr,
+				Outputs:     []types.KudosOutput{{ToAddress: toAddr, Amount: ^uint64(0)}, {ToAddress: otherAddr, Amount: 1}},
+			},
+			expectErr: true,
+			errType:   types.ErrInvalidAmount,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.expectErr {
+				require.Error(t, err)
+				if tt.errType != nil {
+					require.ErrorIs(t, err, tt.errType)
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgSetCategory_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name      string
+		msg       types.MsgSetCategory
+		expectErr bool
+		errType   error
+	}{
+		{
+			name:      "valid message",
+			msg:       types.MsgSetCategory{Authority: fromAddr, Category: types.NewCategory(1, "mentoring", "Helping others grow", true)},
+			expectErr: false,
+		},
+		{
+			name:      "invalid authority",
+			msg:       types.MsgSetCategory{Authority: "invalid", Category: types.NewCategory(1, "mentoring", "", true)},
+			expectErr: true,
+			errType:   types.ErrInvalidAddress,
+		},
+		{
+			name:      "zero id",
+			msg:       types.MsgSetCategory{Authority: fromAddr, Category: types.NewCategory(0, "mentoring", "", true)},
+			expectErr: true,
+			errType:   types.ErrInvalidCategory,
+		},
+		{
+			name:      "empty name",
+			msg:       types.MsgSetCategory{Authority: fromAddr, Category: types.NewCategory(1, " ", "", true)},
+			expectErr: true,
+			errType:   types.ErrInvalidCategory,
+		},
+		{
+			name:      "name too long",
+			msg:       types.MsgSetCategory{Authority: fromAddr, Category: types.NewCategory(1, strings.Repeat("a", types.MaxCategoryNameLength+1), "", true)},
+			expectErr: true,
+			errType:   types.ErrInvalidCategory,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.expectErr {
+				require.Error(t, err)
+				if tt.errType != nil {
+					require.ErrorIs(t, err, tt.errType)
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgRevokeKudos_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name      string
+		msg       types.MsgRevokeKudos
+		expectErr bool
+		errType   error
+	}{
+		{
+			name:      "valid message",
+			msg:       types.MsgRevokeKudos{FromAddress: fromAddr, HistoryId: 1},
+			expectErr: false,
+		},
+		{
+			name:      "invalid from address",
+			msg:       types.MsgRevokeKudos{FromAddress: "invalid", HistoryId: 1},
+			expectErr: true,
+			errType:   types.ErrInvalidAddress,
+		},
+		{
+			name:      "zero history id",
+			msg:       types.MsgRevokeKudos{FromAddress: fromAddr},
+			expectErr: true,
+			errType:   types.ErrInvalidRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.expectErr {
+				require.Error(t, err)
+				if tt.errType != nil {
+					require.ErrorIs(t, err, tt.errType)
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgSetBudgetAllowance_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name      string
+		msg       types.MsgSetBudgetAllowance
+		expectErr bool
+		errType   error
+	}{
+		{
+			name:      "valid message",
+			msg:       types.MsgSetBudgetAllowance{Authority: fromAddr, Address: toAddr, Allowance: 50},
+			expectErr: false,
+		},
+		{
+			name:      "valid removal",
+			msg:       types.MsgSetBudgetAllowance{Authority: fromAddr, Address: toAddr, Remove: true},
+			expectErr: false,
+		},
+		{
+			name:      "invalid authority",
+			msg:       types.MsgSetBudgetAllowance{Authority: "invalid", Address: toAddr, Allowance: 50},
+			expectErr: true,
+			errType:   types.ErrInvalidAddress,
+		},
+		{
+			name:      "invalid address",
+			msg:       types.MsgSetBudgetAllowance{Authority: fromAddr, Address: "invalid", Allowance: 50},
+			expectErr: true,
+			errType:   types.ErrInvalidAddress,
+		},
+		{
+			name:      "removal with allowance",
+			msg:       types.MsgSetBudgetAllowance{Authority: fromAddr, Address: toAddr, Allowance: 50, Remove: true},
+			expectErr: true,
+			errType:   types.ErrInvalidRequest,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.expectErr {
+				require.Error(t, err)
+				if tt.errType != nil {
+					require.ErrorIs(t, err, tt.errType)
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgFundRewardPool_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name      string
+		msg       types.MsgFundRewardPool
+		expectErr bool
+		errType   error
+	}{
+		{
+			name:      "valid message",
+			msg:       types.MsgFundRewardPool{Depositor: fromAddr, Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))},
+			expectErr: false,
+		},
+		{
+			name:      "invalid depositor",
+			msg:       types.MsgFundRewardPool{Depositor: "invalid", Amount: sdk.NewCoins(sdk.NewInt64Coin("stake", 100))},
+			expectErr: true,
+			errType:   types.ErrInvalidAddress,
+		},
+		{
+			name:      "empty amount",
+			msg:       types.MsgFundRewardPool{Depositor: fromAddr},
+			expectErr: true,
+			errType:   types.ErrInvalidAmount,
+		},
+		{
+			name:      "invalid amount",
+			msg:       types.MsgFundRewardPool{Depositor: fromAddr, Amount: sdk.Coins{sdk.Coin{Denom: "stake", Amount: math.NewInt(-1)}}},
+			expectErr: true,
+			errType:   types.ErrInvalidAmount,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.expectErr {
+				require.Error(t, err)
+				if tt.errType != nil {
+					require.ErrorIs(t, err, tt.errType)
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgRedeemKudos_ValidateBasic(t *testing.T) {
+	tests := []struct {
+		name      string
+		msg       types.MsgRedeemKudos
+		expectErr bool
+		errType   error
+	}{
+		{
+			name:      "valid message",
+			msg:       types.MsgRedeemKudos{Address: fromAddr, Amount: 10},
+			expectErr: false,
+		},
+		{
+			name:      "invalid address",
+			msg:       types.MsgRedeemKudos{Address: "invalid", Amount: 10},
+			expectErr: true,
+			errType:   types.ErrInvalidAddress,
+		},
+		{
+			name:      "zero amount",
+			msg:       types.MsgRedeemKudos{Address: fromAddr},
+			expectErr: true,
+			errType:   types.ErrInvalidAmount,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.msg.ValidateBasic()
+			if tt.expectErr {
+				require.Error(t, err)
+				if tt.errType != nil {
+					require.ErrorIs(t, err, tt.errType)
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,184 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultDailyLimit defines how many kudos a user can send within a 24h window
+	DefaultDailyLimit uint64 = 100
+
+	// DailyQuotaWindowSeconds defines how long a quota window lasts (24 hours)
+	DailyQuotaWindowSeconds = 60 * 60 * 24
+
+	// DefaultMaxCommentLength defines the default maximum comment length
+	DefaultMaxCommentLength uint32 = 140
+
+	// DefaultLeaderboardLimit defines how many entries a leaderboard query returns by default
+	DefaultLeaderboardLimit uint32 = 10
+
+	// DefaultRevokeGracePeriodSeconds defines how long a sender can revoke a transfer by default (1 hour)
+	DefaultRevokeGracePeriodSeconds uint64 = 60 * 60
+
+	// DefaultDecayEpochSeconds defines how often balances decay once decay is enabled (30 days)
+	DefaultDecayEpochSeconds uint64 = 60 * 60 * 24 * 30
+
+	// DefaultDecayAccountsPerBlock defines how many balances the BeginBlocker decays per block
+	DefaultDecayAccountsPerBlock uint32 = 100
+
+	// DefaultSeasonAccountsPerBlock defines how many balances the BeginBlocker archives per block when a season ends
+	DefaultSeasonAccountsPerBlock uint32 = 100
+
+	// MaxDecayPercent is the highest decay_percent; 100 clears every balance each epoch
+	MaxDecayPercent uint32 = 100
+
+	// DefaultBudgetPerEpoch defines the giveable allowance per budget epoch once budget mode is enabled
+	DefaultBudgetPerEpoch uint64 = 100
+
+	// DefaultBudgetEpochSeconds defines how often giveable allowances refill (7 days)
+	DefaultBudgetEpochSeconds uint64 = 60 * 60 * 24 * 7
+
+	// DefaultRedeemEpochSeconds defines how often the per-address redemption cap resets (30 days)
+	DefaultRedeemEpochSeconds uint64 = 60 * 60 * 24 * 30
+
+	// MaxCommentLengthCap bounds the comment length governance can allow
+	MaxCommentLengthCap uint32 = 1024
+)
+
+// NewParams creates a new Params instance
+func NewParams(
+	dailyLimit, quotaWindowSeconds uint64,
+	maxCommentLength, defaultLeaderboardLimit uint32,
+	revokeGracePeriodSeconds uint64,
+	decayPercent uint32, decayEpochSeconds uint64, decayAccountsPerBlock uint32,
+	seasonLengthSeconds uint64, seasonAccountsPerBlock uint32,
+	budgetEnabled bool, budgetPerEpoch, budgetEpochSeconds, budgetRolloverCap uint64, budgetAdmin string,
+	allowedTipDenoms []string,
+	redeemDenom string, redeemRate, redeemEpochSeconds, redeemCapPerEpoch uint64,
+) Params {
+	return Params{
+		DailyLimit:               dailyLimit,
+		QuotaWindowSeconds:       quotaWindowSeconds,
+		MaxCommentLength:         maxCommentLength,
+		DefaultLeaderboardLimit:  defaultLeaderboardLimit,
+		RevokeGracePeriodSeconds: revokeGracePeriodSeconds,
+		DecayPercent:             decayPercent,
+		DecayEpochSeconds:        decayEpochSeconds,
+		DecayAccountsPerBlock:    decayAccountsPerBlock,
+		SeasonLengthSeconds:      seasonLengthSeconds,
+		SeasonAccountsPerBlock:   seasonAccountsPerBlock,
+		BudgetEnabled:            budgetEnabled,
+		BudgetPerEpoch:           budgetPerEpoch,
+		BudgetEpochSeconds:       budgetEpochSeconds,
+		BudgetRolloverCap:        budgetRolloverCap,
+		BudgetAdmin:              budgetAdmin,
+		AllowedTipDenoms:         allowedTipDenoms,
+		RedeemDenom:              redeemDenom,
+		RedeemRate:               redeemRate,
+		RedeemEpochSeconds:       redeemEpochSeconds,
+		RedeemCapPerEpoch:        redeemCapPerEpoch,
+	}
+}
+
+// DefaultParams returns the default set of parameters; decay, seasons, budget mode, tips and redemption
+// are disabled by default
+func DefaultParams() Params {
+	return NewParams(
+		DefaultDailyLimit, DailyQuotaWindowSeconds,
+		DefaultMaxCommentLength, DefaultLeaderboardLimit,
+		DefaultRevokeGracePeriodSeconds,
+		0, DefaultDecayEpochSeconds, DefaultDecayAccountsPerBlock,
+		0, DefaultSeasonAccountsPerBlock,
+		false, DefaultBudgetPerEpoch, DefaultBudgetEpochSeconds, 0, "",
+		nil,
+		"", 0, DefaultRedeemEpochSeconds, 0,
+	)
+}
+
+// Validate validates the set of parameters
+func (p Params) Validate() error {
+	if p.DailyLimit == 0 {
+		return fmt.Errorf("daily limit must be greater than 0")
+	}
+
+	if p.QuotaWindowSeconds == 0 {
+		return fmt.Errorf("quota window must be greater than 0")
+	}
+
+	if p.MaxCommentLength == 0 {
+		return fmt.Errorf("max comment length must be greater than 0")
+	}
+
+	if p.MaxCommentLength > MaxCommentLengthCap {
+		return fmt.Errorf("max comment length %d exceeds cap %d", p.MaxCommentLength, MaxCommentLengthCap)
+	}
+
+	if p.DefaultLeaderboardLimit == 0 {
+		return fmt.Errorf("default leaderboard limit must be greater than 0")
+	}
+
+	if p.DecayPercent > MaxDecayPercent {
+		return fmt.Errorf("decay percent %d exceeds %d", p.DecayPercent, MaxDecayPercent)
+	}
+
+	if p.DecayPercent > 0 {
+		if p.DecayEpochSeconds == 0 {
+			return fmt.Errorf("decay epoch must be greater than 0 when decay is enabled")
+		}
+		if p.DecayAccountsPerBlock == 0 {
+			return fmt.Errorf("decay accounts per block must be greater than 0 when decay is enabled")
+		}
+	}
+
+	if p.SeasonAccountsPerBlock == 0 {
+		return fmt.Errorf("season accounts per block must be greater than 0")
+	}
+
+	if p.BudgetEnabled && p.BudgetEpochSeconds == 0 {
+		return fmt.Errorf("budget epoch must be greater than 0 when budget mode is enabled")
+	}
+
+	if p.BudgetAdmin != "" {
+		if _, err := sdk.AccAddressFromBech32(p.BudgetAdmin); err != nil {
+			return fmt.Errorf("invalid budget admin address %q: %w", p.BudgetAdmin, err)
+		}
+	}
+
+	seenDenoms := make(map[string]bool, len(p.AllowedTipDenoms))
+	for _, denom := range p.AllowedTipDenoms {
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("invalid allowed tip denom: %w", err)
+		}
+		if seenDenoms[denom] {
+			return fmt.Errorf("duplicate allowed tip denom %s", denom)
+		}
+		seenDenoms[denom] = true
+	}
+
+	if p.RedeemDenom != "" {
+		if err := sdk.ValidateDenom(p.RedeemDenom); err != nil {
+			return fmt.Errorf("invalid redeem denom: %w", err)
+		}
+		if p.RedeemRate == 0 {
+			return fmt.Errorf("redeem rate must be greater than 0 when redemption is enabled")
+		}
+	}
+
+	if p.RedeemCapPerEpoch > 0 && p.RedeemEpochSeconds == 0 {
+		return fmt.Errorf("redeem epoch must be greater than 0 when the redemption cap is enabled")
+	}
+
+	return nil
+}
+
+// IsTipDenomAllowed reports whether coins of denom may be attached to kudos as a tip
+func (p Params) IsTipDenomAllowed(denom string) bool {
+	for _, allowed := range p.AllowedTipDenoms {
+		if allowed == denom {
+			return true
+		}
+	}
+	return false
+}
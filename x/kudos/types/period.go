@@ -0,0 +1,70 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	// PeriodBucketSeconds is the width of a period bucket; windowed leaderboards have day granularity
+	PeriodBucketSeconds = 60 * 60 * 24
+
+	// MaxPeriodDays bounds how many day buckets a single windowed leaderboard query may aggregate
+	MaxPeriodDays = 366
+
+	// PeriodWeek selects the last 7 days, including the current day
+	PeriodWeek = "week"
+
+	// PeriodMonth selects the last 30 days, including the current day
+	PeriodMonth = "month"
+)
+
+// PeriodDay returns the index of the day bucket a block time falls into
+func PeriodDay(t time.Time) uint64 {
+	if t.Unix() < 0 {
+		return 0
+	}
+	return uint64(t.Unix()) / PeriodBucketSeconds
+}
+
+// ResolvePeriod converts a named period or an explicit [startTime, endTime) range in unix seconds
+// into an inclusive range of day buckets. An unset endTime defaults to now.
+func ResolvePeriod(period string, startTime, endTime int64, now time.Time) (uint64, uint64, error) {
+	var days uint64
+	switch period {
+	case PeriodWeek:
+		days = 7
+	case PeriodMonth:
+		days = 30
+	case "":
+	default:
+		return 0, 0, errorsmod.Wrapf(ErrInvalidLeaderboard, "unknown period %q, expected %q or %q", period, PeriodWeek, PeriodMonth)
+	}
+
+	if days > 0 {
+		if startTime != 0 || endTime != 0 {
+			return 0, 0, errorsmod.Wrap(ErrInvalidLeaderboard, "period cannot be combined with start or end time")
+		}
+		endDay := PeriodDay(now)
+		if endDay < days-1 {
+			return 0, endDay, nil
+		}
+		return endDay - (days - 1), endDay, nil
+	}
+
+	if endTime == 0 {
+		endTime = now.Unix() + 1
+	}
+	if startTime < 0 || endTime <= startTime {
+		return 0, 0, errorsmod.Wrapf(ErrInvalidLeaderboard, "invalid time range [%d, %d)", startTime, endTime)
+	}
+
+	startDay := PeriodDay(time.Unix(startTime, 0))
+	endDay := PeriodDay(time.Unix(endTime-1, 0))
+	if endDay-startDay+1 > MaxPeriodDays {
+		return 0, 0, errorsmod.Wrapf(ErrInvalidLeaderboard, "time range spans %d days, maximum is %d", endDay-startDay+1, MaxPeriodDays)
+	}
+
+	return startDay, endDay, nil
+}
@@ -0,0 +1,46 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/pavlenkotm/cosmos-kudos-module/x/kudos/types"
+)
+
+func TestResolvePeriod(t *testing.T) {
+	now := time.Unix(100*types.PeriodBucketSeconds+3600, 0)
+
+	tests := []struct {
+		name      string
+		period    string
+		start     int64
+		end       int64
+		startDay  uint64
+		endDay    uint64
+		expectErr bool
+	}{
+		{name: "week", period: types.PeriodWeek, startDay: 94, endDay: 100},
+		{name: "month", period: types.PeriodMonth, startDay: 71, endDay: 100},
+		{name: "explicit range", start: 90 * types.PeriodBucketSeconds, end: 92 * types.PeriodBucketSeconds, startDay: 90, endDay: 91},
+		{name: "open end defaults to now", start: 99 * types.PeriodBucketSeconds, startDay: 99, endDay: 100},
+		{name: "unknown period", period: "year", expectErr: true},
+		{name: "period with range", period: types.PeriodWeek, start: 1, expectErr: true},
+		{name: "empty range", start: 10, end: 10, expectErr: true},
+		{name: "range too long", start: 1, end: (types.MaxPeriodDays + 1) * types.PeriodBucketSeconds, expectErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			startDay, endDay, err := types.ResolvePeriod(tt.period, tt.start, tt.end, now)
+			if tt.expectErr {
+				require.ErrorIs(t, err, types.ErrInvalidLeaderboard)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.startDay, startDay)
+			require.Equal(t, tt.endDay, endDay)
+		})
+	}
+}
@@ -6,12 +6,19 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
-	proto "github.com/cosmos/gogoproto/proto"
-	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	io "io"
 	math "math"
+	math_bits "math/bits"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -21,7 +28,9 @@ var _ = math.Inf
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
-const _ = proto.GoGoProtoPackageIsVersion3
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // MsgSendKudos represents a message to send kudos
 type MsgSendKudos struct {
@@ -29,11 +38,86 @@ type MsgSendKudos struct {
 	ToAddress   string `protobuf:"bytes,2,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty" yaml:"to_address"`
 	Amount      uint64 `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`
 	Comment     string `protobuf:"bytes,4,opt,name=comment,proto3" json:"comment,omitempty"`
+	CategoryId  uint64 `protobuf:"varint,5,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty" yaml:"category_id"`
+	// tip is an optional amount of coins sent from sender to recipient together with the kudos;
+	// every denom must be listed in params.allowed_tip_denoms
+	Tip github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=tip,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"tip"`
 }
 
 func (m *MsgSendKudos) Reset()         { *m = MsgSendKudos{} }
 func (m *MsgSendKudos) String() string { return proto.CompactTextString(m) }
 func (*MsgSendKudos) ProtoMessage()    {}
+func (*MsgSendKudos) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cfc7cc575f25883, []int{0}
+}
+func (m *MsgSendKudos) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendKudos) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendKudos.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSendKudos) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendKudos.Merge(m, src)
+}
+func (m *MsgSendKudos) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendKudos) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendKudos.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSendKudos proto.InternalMessageInfo
+
+func (m *MsgSendKudos) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *MsgSendKudos) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
+}
+
+func (m *MsgSendKudos) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *MsgSendKudos) GetComment() string {
+	if m != nil {
+		return m.Comment
+	}
+	return ""
+}
+
+func (m *MsgSendKudos) GetCategoryId() uint64 {
+	if m != nil {
+		return m.CategoryId
+	}
+	return 0
+}
+
+func (m *MsgSendKudos) GetTip() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Tip
+	}
+	return nil
+}
 
 // MsgSendKudosResponse is the response for SendKudos
 type MsgSendKudosResponse struct {
@@ -42,88 +126,3812 @@ type MsgSendKudosResponse struct {
 func (m *MsgSendKudosResponse) Reset()         { *m = MsgSendKudosResponse{} }
 func (m *MsgSendKudosResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSendKudosResponse) ProtoMessage()    {}
+func (*MsgSendKudosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cfc7cc575f25883, []int{1}
+}
+func (m *MsgSendKudosResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendKudosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendKudosResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSendKudosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendKudosResponse.Merge(m, src)
+}
+func (m *MsgSendKudosResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendKudosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendKudosResponse.DiscardUnknown(m)
+}
 
-func init() {
-	proto.RegisterType((*MsgSendKudos)(nil), "kudos.MsgSendKudos")
-	proto.RegisterType((*MsgSendKudosResponse)(nil), "kudos.MsgSendKudosResponse")
+var xxx_messageInfo_MsgSendKudosResponse proto.InternalMessageInfo
+
+// MsgUpdateParams is the governance message to update the module parameters
+type MsgUpdateParams struct {
+	// authority is the address that controls the module (defaults to x/gov)
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the module parameters to update; all fields must be supplied
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cfc7cc575f25883, []int{2}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-type MsgClient interface {
-	// SendKudos sends kudos from one address to another
-	SendKudos(ctx context.Context, in *MsgSendKudos, opts ...grpc.CallOption) (*MsgSendKudosResponse, error)
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc.ClientConnInterface
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
 }
 
-func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
-	return &msgClient{cc}
+// MsgUpdateParamsResponse is the response for UpdateParams
+type MsgUpdateParamsResponse struct {
 }
 
-func (c *msgClient) SendKudos(ctx context.Context, in *MsgSendKudos, opts ...grpc.CallOption) (*MsgSendKudosResponse, error) {
-	out := new(MsgSendKudosResponse)
-	err := c.cc.Invoke(ctx, "/kudos.Msg/SendKudos", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cfc7cc575f25883, []int{3}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// SendKudos sends kudos from one address to another
-	SendKudos(context.Context, *MsgSendKudos) (*MsgSendKudosResponse, error)
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+// KudosOutput is a single recipient of a MsgMultiSendKudos
+type KudosOutput struct {
+	ToAddress  string `protobuf:"bytes,1,opt,name=to_address,json=toAddress,proto3" json:"to_address,omitempty" yaml:"to_address"`
+	Amount     uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Comment    string `protobuf:"bytes,3,opt,name=comment,proto3" json:"comment,omitempty"`
+	CategoryId uint64 `protobuf:"varint,4,opt,name=category_id,json=categoryId,proto3" json:"category_id,omitempty" yaml:"category_id"`
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *KudosOutput) Reset()         { *m = KudosOutput{} }
+func (m *KudosOutput) String() string { return proto.CompactTextString(m) }
+func (*KudosOutput) ProtoMessage()    {}
+func (*KudosOutput) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cfc7cc575f25883, []int{4}
+}
+func (m *KudosOutput) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *KudosOutput) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_KudosOutput.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *KudosOutput) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KudosOutput.Merge(m, src)
+}
+func (m *KudosOutput) XXX_Size() int {
+	return m.Size()
+}
+func (m *KudosOutput) XXX_DiscardUnknown() {
+	xxx_messageInfo_KudosOutput.DiscardUnknown(m)
 }
 
-func (*UnimplementedMsgServer) SendKudos(ctx context.Context, req *MsgSendKudos) (*MsgSendKudosResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendKudos not implemented")
+var xxx_messageInfo_KudosOutput proto.InternalMessageInfo
+
+func (m *KudosOutput) GetToAddress() string {
+	if m != nil {
+		return m.ToAddress
+	}
+	return ""
 }
 
-func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *KudosOutput) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
 }
 
-func _Msg_SendKudos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSendKudos)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *KudosOutput) GetComment() string {
+	if m != nil {
+		return m.Comment
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SendKudos(ctx, in)
+	return ""
+}
+
+func (m *KudosOutput) GetCategoryId() uint64 {
+	if m != nil {
+		return m.CategoryId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/kudos.Msg/SendKudos",
+	return 0
+}
+
+// MsgMultiSendKudos sends kudos to several recipients in one message. All outputs
+// succeed or fail together and their total counts once against the daily quota.
+type MsgMultiSendKudos struct {
+	FromAddress string        `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty" yaml:"from_address"`
+	Outputs     []KudosOutput `protobuf:"bytes,2,rep,name=outputs,proto3" json:"outputs"`
+}
+
+func (m *MsgMultiSendKudos) Reset()         { *m = MsgMultiSendKudos{} }
+func (m *MsgMultiSendKudos) String() string { return proto.CompactTextString(m) }
+func (*MsgMultiSendKudos) ProtoMessage()    {}
+func (*MsgMultiSendKudos) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cfc7cc575f25883, []int{5}
+}
+func (m *MsgMultiSendKudos) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMultiSendKudos) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMultiSendKudos.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SendKudos(ctx, req.(*MsgSendKudos))
+}
+func (m *MsgMultiSendKudos) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMultiSendKudos.Merge(m, src)
+}
+func (m *MsgMultiSendKudos) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMultiSendKudos) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMultiSendKudos.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMultiSendKudos proto.InternalMessageInfo
+
+func (m *MsgMultiSendKudos) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "kudos.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "SendKudos",
-			Handler:    _Msg_SendKudos_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "kudos/tx.proto",
+func (m *MsgMultiSendKudos) GetOutputs() []KudosOutput {
+	if m != nil {
+		return m.Outputs
+	}
+	return nil
+}
+
+// MsgMultiSendKudosResponse is the response for MultiSendKudos
+type MsgMultiSendKudosResponse struct {
+}
+
+func (m *MsgMultiSendKudosResponse) Reset()         { *m = MsgMultiSendKudosResponse{} }
+func (m *MsgMultiSendKudosResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMultiSendKudosResponse) ProtoMessage()    {}
+func (*MsgMultiSendKudosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cfc7cc575f25883, []int{6}
+}
+func (m *MsgMultiSendKudosResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMultiSendKudosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMultiSendKudosResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMultiSendKudosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMultiSendKudosResponse.Merge(m, src)
+}
+func (m *MsgMultiSendKudosResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMultiSendKudosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMultiSendKudosResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMultiSendKudosResponse proto.InternalMessageInfo
+
+// MsgSetCategory is the governance message to create or update a kudos category.
+// Setting active to false retires the category without touching existing balances.
+type MsgSetCategory struct {
+	// authority is the address that controls the module (defaults to x/gov)
+	Authority string   `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Category  Category `protobuf:"bytes,2,opt,name=category,proto3" json:"category"`
+}
+
+func (m *MsgSetCategory) Reset()         { *m = MsgSetCategory{} }
+func (m *MsgSetCategory) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCategory) ProtoMessage()    {}
+func (*MsgSetCategory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cfc7cc575f25883, []int{7}
+}
+func (m *MsgSetCategory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCategory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCategory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetCategory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCategory.Merge(m, src)
+}
+func (m *MsgSetCategory) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCategory) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCategory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCategory proto.InternalMessageInfo
+
+func (m *MsgSetCategory) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetCategory) GetCategory() Category {
+	if m != nil {
+		return m.Category
+	}
+	return Category{}
+}
+
+// MsgSetCategoryResponse is the response for SetCategory
+type MsgSetCategoryResponse struct {
+}
+
+func (m *MsgSetCategoryResponse) Reset()         { *m = MsgSetCategoryResponse{} }
+func (m *MsgSetCategoryResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCategoryResponse) ProtoMessage()    {}
+func (*MsgSetCategoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cfc7cc575f25883, []int{8}
+}
+func (m *MsgSetCategoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCategoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCategoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetCategoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCategoryResponse.Merge(m, src)
+}
+func (m *MsgSetCategoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCategoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCategoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCategoryResponse proto.InternalMessageInfo
+
+// MsgRevokeKudos reverses a kudos transfer. Only the original sender may revoke it and
+// only within params.revoke_grace_period_seconds of the block that recorded it.
+type MsgRevokeKudos struct {
+	FromAddress string `protobuf:"bytes,1,opt,name=from_address,json=fromAddress,proto3" json:"from_address,omitempty" yaml:"from_address"`
+	HistoryId   uint64 `protobuf:"varint,2,opt,name=history_id,json=historyId,proto3" json:"history_id,omitempty" yaml:"history_id"`
+}
+
+func (m *MsgRevokeKudos) Reset()         { *m = MsgRevokeKudos{} }
+func (m *MsgRevokeKudos) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeKudos) ProtoMessage()    {}
+func (*MsgRevokeKudos) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cfc7cc575f25883, []int{9}
+}
+func (m *MsgRevokeKudos) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeKudos) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeKudos.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeKudos) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeKudos.Merge(m, src)
+}
+func (m *MsgRevokeKudos) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeKudos) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeKudos.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeKudos proto.InternalMessageInfo
+
+func (m *MsgRevokeKudos) GetFromAddress() string {
+	if m != nil {
+		return m.FromAddress
+	}
+	return ""
+}
+
+func (m *MsgRevokeKudos) GetHistoryId() uint64 {
+	if m != nil {
+		return m.HistoryId
+	}
+	return 0
+}
+
+// MsgRevokeKudosResponse is the response for RevokeKudos
+type MsgRevokeKudosResponse struct {
+}
+
+func (m *MsgRevokeKudosResponse) Reset()         { *m = MsgRevokeKudosResponse{} }
+func (m *MsgRevokeKudosResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeKudosResponse) ProtoMessage()    {}
+func (*MsgRevokeKudosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cfc7cc575f25883, []int{10}
+}
+func (m *MsgRevokeKudosResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeKudosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeKudosResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeKudosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeKudosResponse.Merge(m, src)
+}
+func (m *MsgRevokeKudosResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeKudosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeKudosResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeKudosResponse proto.InternalMessageInfo
+
+// MsgSetBudgetAllowance overrides the giveable allowance an address receives each budget epoch.
+// Setting remove deletes the override so the address falls back to params.budget_per_epoch.
+type MsgSetBudgetAllowance struct {
+	// authority is the module authority or params.budget_admin
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Address   string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Allowance uint64 `protobuf:"varint,3,opt,name=allowance,proto3" json:"allowance,omitempty"`
+	Remove    bool   `protobuf:"varint,4,opt,name=remove,proto3" json:"remove,omitempty"`
+}
+
+func (m *MsgSetBudgetAllowance) Reset()         { *m = MsgSetBudgetAllowance{} }
+func (m *MsgSetBudgetAllowance) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBudgetAllowance) ProtoMessage()    {}
+func (*MsgSetBudgetAllowance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cfc7cc575f25883, []int{11}
+}
+func (m *MsgSetBudgetAllowance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBudgetAllowance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBudgetAllowance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetBudgetAllowance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBudgetAllowance.Merge(m, src)
+}
+func (m *MsgSetBudgetAllowance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBudgetAllowance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBudgetAllowance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetBudgetAllowance proto.InternalMessageInfo
+
+func (m *MsgSetBudgetAllowance) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetBudgetAllowance) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgSetBudgetAllowance) GetAllowance() uint64 {
+	if m != nil {
+		return m.Allowance
+	}
+	return 0
+}
+
+func (m *MsgSetBudgetAllowance) GetRemove() bool {
+	if m != nil {
+		return m.Remove
+	}
+	return false
+}
+
+// MsgSetBudgetAllowanceResponse is the response for SetBudgetAllowance
+type MsgSetBudgetAllowanceResponse struct {
+}
+
+func (m *MsgSetBudgetAllowanceResponse) Reset()         { *m = MsgSetBudgetAllowanceResponse{} }
+func (m *MsgSetBudgetAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetBudgetAllowanceResponse) ProtoMessage()    {}
+func (*MsgSetBudgetAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cfc7cc575f25883, []int{12}
+}
+func (m *MsgSetBudgetAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetBudgetAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetBudgetAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetBudgetAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetBudgetAllowanceResponse.Merge(m, src)
+}
+func (m *MsgSetBudgetAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetBudgetAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetBudgetAllowanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetBudgetAllowanceResponse proto.InternalMessageInfo
+
+// MsgFundRewardPool deposits coins into the reward pool. Anyone may fund the pool, but only
+// in params.redeem_denom so that every deposit can be paid out.
+type MsgFundRewardPool struct {
+	Depositor string                                   `protobuf:"bytes,1,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *MsgFundRewardPool) Reset()         { *m = MsgFundRewardPool{} }
+func (m *MsgFundRewardPool) String() string { return proto.CompactTextString(m) }
+func (*MsgFundRewardPool) ProtoMessage()    {}
+func (*MsgFundRewardPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cfc7cc575f25883, []int{13}
+}
+func (m *MsgFundRewardPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundRewardPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundRewardPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundRewardPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundRewardPool.Merge(m, src)
+}
+func (m *MsgFundRewardPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundRewardPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundRewardPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundRewardPool proto.InternalMessageInfo
+
+func (m *MsgFundRewardPool) GetDepositor() string {
+	if m != nil {
+		return m.Depositor
+	}
+	return ""
+}
+
+func (m *MsgFundRewardPool) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+// MsgFundRewardPoolResponse is the response for FundRewardPool
+type MsgFundRewardPoolResponse struct {
+}
+
+func (m *MsgFundRewardPoolResponse) Reset()         { *m = MsgFundRewardPoolResponse{} }
+func (m *MsgFundRewardPoolResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundRewardPoolResponse) ProtoMessage()    {}
+func (*MsgFundRewardPoolResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cfc7cc575f25883, []int{14}
+}
+func (m *MsgFundRewardPoolResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundRewardPoolResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundRewardPoolResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
+func (m *MsgFundRewardPoolResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundRewardPoolResponse.Merge(m, src)
+}
+func (m *MsgFundRewardPoolResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundRewardPoolResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundRewardPoolResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundRewardPoolResponse proto.InternalMessageInfo
+
+// MsgRedeemKudos burns amount kudos from the signer's balance and pays out
+// amount * params.redeem_rate of params.redeem_denom from the reward pool.
+type MsgRedeemKudos struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Amount  uint64 `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (m *MsgRedeemKudos) Reset()         { *m = MsgRedeemKudos{} }
+func (m *MsgRedeemKudos) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemKudos) ProtoMessage()    {}
+func (*MsgRedeemKudos) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cfc7cc575f25883, []int{15}
+}
+func (m *MsgRedeemKudos) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedeemKudos) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeemKudos.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRedeemKudos) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemKudos.Merge(m, src)
+}
+func (m *MsgRedeemKudos) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeemKudos) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemKudos.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRedeemKudos proto.InternalMessageInfo
+
+func (m *MsgRedeemKudos) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MsgRedeemKudos) GetAmount() uint64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+// MsgRedeemKudosResponse is the response for RedeemKudos
+type MsgRedeemKudosResponse struct {
+	RedemptionId uint64     `protobuf:"varint,1,opt,name=redemption_id,json=redemptionId,proto3" json:"redemption_id,omitempty" yaml:"redemption_id"`
+	Payout       types.Coin `protobuf:"bytes,2,opt,name=payout,proto3" json:"payout"`
+}
+
+func (m *MsgRedeemKudosResponse) Reset()         { *m = MsgRedeemKudosResponse{} }
+func (m *MsgRedeemKudosResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRedeemKudosResponse) ProtoMessage()    {}
+func (*MsgRedeemKudosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1cfc7cc575f25883, []int{16}
+}
+func (m *MsgRedeemKudosResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedeemKudosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedeemKudosResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRedeemKudosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedeemKudosResponse.Merge(m, src)
+}
+func (m *MsgRedeemKudosResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedeemKudosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedeemKudosResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRedeemKudosResponse proto.InternalMessageInfo
+
+func (m *MsgRedeemKudosResponse) GetRedemptionId() uint64 {
+	if m != nil {
+		return m.RedemptionId
+	}
+	return 0
+}
+
+func (m *MsgRedeemKudosResponse) GetPayout() types.Coin {
+	if m != nil {
+		return m.Payout
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*MsgSendKudos)(nil), "kudos.MsgSendKudos")
+	proto.RegisterType((*MsgSendKudosResponse)(nil), "kudos.MsgSendKudosResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "kudos.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "kudos.MsgUpdateParamsResponse")
+	proto.RegisterType((*KudosOutput)(nil), "kudos.KudosOutput")
+	proto.RegisterType((*MsgMultiSendKudos)(nil), "kudos.MsgMultiSendKudos")
+	proto.RegisterType((*MsgMultiSendKudosResponse)(nil), "kudos.MsgMultiSendKudosResponse")
+	proto.RegisterType((*MsgSetCategory)(nil), "kudos.MsgSetCategory")
+	proto.RegisterType((*MsgSetCategoryResponse)(nil), "kudos.MsgSetCategoryResponse")
+	proto.RegisterType((*MsgRevokeKudos)(nil), "kudos.MsgRevokeKudos")
+	proto.RegisterType((*MsgRevokeKudosResponse)(nil), "kudos.MsgRevokeKudosResponse")
+	proto.RegisterType((*MsgSetBudgetAllowance)(nil), "kudos.MsgSetBudgetAllowance")
+	proto.RegisterType((*MsgSetBudgetAllowanceResponse)(nil), "kudos.MsgSetBudgetAllowanceResponse")
+	proto.RegisterType((*MsgFundRewardPool)(nil), "kudos.MsgFundRewardPool")
+	proto.RegisterType((*MsgFundRewardPoolResponse)(nil), "kudos.MsgFundRewardPoolResponse")
+	proto.RegisterType((*MsgRedeemKudos)(nil), "kudos.MsgRedeemKudos")
+	proto.RegisterType((*MsgRedeemKudosResponse)(nil), "kudos.MsgRedeemKudosResponse")
+}
+
+func init() { proto.RegisterFile("kudos/tx.proto", fileDescriptor_1cfc7cc575f25883) }
+
+var fileDescriptor_1cfc7cc575f25883 = []byte{
+	// 986 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x3d, 0x6f, 0xdb, 0x46,
+	0x18, 0x36, 0x2d, 0xd9, 0x8e, 0x4e, 0x8a, 0x03, 0x33, 0xb2, 0x4c, 0x33, 0xb1, 0x24, 0x10, 0x1d,
+	0x8c, 0x14, 0x16, 0x6b, 0xb5, 0xa8, 0x01, 0x01, 0x19, 0x22, 0x03, 0x01, 0x8c, 0x56, 0x68, 0xca,
+	0xa0, 0x40, 0x51, 0xa0, 0x30, 0x68, 0xf1, 0x4a, 0xb3, 0x16, 0x79, 0x04, 0xef, 0xa8, 0x44, 0x5b,
+	0xd1, 0xa1, 0x4b, 0x97, 0x2c, 0xed, 0x8f, 0xe8, 0x50, 0x64, 0xe8, 0x0f, 0xe8, 0x98, 0x6e, 0x41,
+	0xa7, 0x2c, 0x55, 0x0b, 0x7b, 0xc8, 0xae, 0x5f, 0x50, 0x1c, 0xef, 0x78, 0x3c, 0xca, 0x94, 0x9b,
+	0x1a, 0x9e, 0xa4, 0xf7, 0xf3, 0x9e, 0xf7, 0x79, 0x3f, 0x24, 0xb0, 0x7e, 0x16, 0x3b, 0x08, 0x9b,
+	0xe4, 0x79, 0x27, 0x8c, 0x10, 0x41, 0xea, 0x4a, 0x22, 0xeb, 0x75, 0x17, 0xb9, 0x28, 0xd1, 0x98,
+	0xf4, 0x1b, 0x33, 0xea, 0x5b, 0x43, 0x84, 0x7d, 0x84, 0x4d, 0x1f, 0xbb, 0xe6, 0x78, 0x9f, 0x7e,
+	0x70, 0xc3, 0x36, 0x33, 0x1c, 0xb3, 0x08, 0x26, 0x70, 0x53, 0x93, 0xc7, 0x9c, 0xd8, 0x18, 0x9a,
+	0xe3, 0xfd, 0x13, 0x48, 0xec, 0x7d, 0x73, 0x88, 0xbc, 0x80, 0xdb, 0x55, 0x06, 0x20, 0xb4, 0x23,
+	0xdb, 0x4f, 0x63, 0xea, 0x4c, 0x37, 0xb4, 0x09, 0x74, 0x51, 0x34, 0x61, 0x5a, 0xe3, 0xaf, 0x65,
+	0x50, 0x1b, 0x60, 0xf7, 0x29, 0x0c, 0x9c, 0x4f, 0xa8, 0x5d, 0xed, 0x81, 0xda, 0x37, 0x11, 0xf2,
+	0x8f, 0x6d, 0xc7, 0x89, 0x20, 0xc6, 0x9a, 0xd2, 0x56, 0x76, 0x2b, 0xfd, 0xad, 0xd9, 0xb4, 0x75,
+	0x77, 0x62, 0xfb, 0xa3, 0x9e, 0x21, 0x5b, 0x0d, 0xab, 0x4a, 0xc5, 0x47, 0x4c, 0x52, 0x3f, 0x02,
+	0x80, 0x20, 0x11, 0xb9, 0x9c, 0x44, 0x6e, 0xce, 0xa6, 0xad, 0x0d, 0x16, 0x99, 0xd9, 0x0c, 0xab,
+	0x42, 0x50, 0x1a, 0xd5, 0x00, 0xab, 0xb6, 0x8f, 0xe2, 0x80, 0x68, 0xa5, 0xb6, 0xb2, 0x5b, 0xb6,
+	0xb8, 0xa4, 0x6a, 0x60, 0x6d, 0x88, 0x7c, 0x1f, 0x06, 0x44, 0x2b, 0xd3, 0x54, 0x56, 0x2a, 0xaa,
+	0x07, 0xa0, 0x9a, 0x96, 0x71, 0xec, 0x39, 0xda, 0x0a, 0x0d, 0xeb, 0x37, 0x66, 0xd3, 0x96, 0xca,
+	0x1e, 0x92, 0x8c, 0x86, 0x05, 0x52, 0xe9, 0xc8, 0x51, 0xbf, 0x06, 0x25, 0xe2, 0x85, 0xda, 0x6a,
+	0xbb, 0xb4, 0x5b, 0xed, 0x6e, 0x77, 0x38, 0xa7, 0x94, 0xc5, 0x0e, 0x67, 0xb1, 0x73, 0x88, 0xbc,
+	0xa0, 0xff, 0xc1, 0xab, 0x69, 0x6b, 0xe9, 0x97, 0xbf, 0x5b, 0xbb, 0xae, 0x47, 0x4e, 0xe3, 0x93,
+	0xce, 0x10, 0xf9, 0xbc, 0x01, 0xfc, 0x63, 0x0f, 0x3b, 0x67, 0x26, 0x99, 0x84, 0x10, 0x27, 0x01,
+	0xd8, 0xa2, 0x79, 0x7b, 0x1b, 0xdf, 0xbf, 0x7d, 0xf9, 0x20, 0x47, 0x9f, 0xd1, 0x00, 0x75, 0x99,
+	0x5e, 0x0b, 0xe2, 0x10, 0x05, 0x18, 0x1a, 0x3f, 0x28, 0xe0, 0xce, 0x00, 0xbb, 0x5f, 0x84, 0x8e,
+	0x4d, 0xe0, 0x93, 0xa4, 0x4f, 0xea, 0xc7, 0xa0, 0x62, 0xc7, 0xe4, 0x14, 0x45, 0x1e, 0x99, 0x70,
+	0xde, 0xb5, 0x3f, 0x7f, 0xdb, 0xab, 0x73, 0x98, 0x9c, 0xaf, 0xa7, 0x24, 0xf2, 0x02, 0xd7, 0xca,
+	0x5c, 0xd5, 0xf7, 0xc1, 0x2a, 0xeb, 0x74, 0x42, 0x79, 0xb5, 0x7b, 0xbb, 0x93, 0xb4, 0xba, 0xc3,
+	0xd2, 0xf6, 0xcb, 0xb4, 0x18, 0x8b, 0xbb, 0xf4, 0xd6, 0x29, 0xc6, 0x2c, 0xd8, 0xd8, 0x06, 0x5b,
+	0x73, 0x38, 0x04, 0xc6, 0x5f, 0x15, 0x50, 0x4d, 0x50, 0x7f, 0x16, 0x93, 0x30, 0x26, 0x73, 0xed,
+	0x55, 0xfe, 0x77, 0x7b, 0x97, 0x17, 0xb5, 0xb7, 0x74, 0x65, 0x7b, 0xcb, 0xef, 0xda, 0x5e, 0xe3,
+	0x67, 0x05, 0x6c, 0x0c, 0xb0, 0x3b, 0x88, 0x47, 0xc4, 0xbb, 0x99, 0x89, 0xee, 0x82, 0x35, 0x94,
+	0x14, 0x4f, 0xb9, 0xa5, 0x43, 0xa3, 0x72, 0x6e, 0x25, 0x5e, 0x38, 0xc1, 0xa9, 0x63, 0xd1, 0x14,
+	0xdc, 0x03, 0xdb, 0x97, 0x70, 0x09, 0x9a, 0x7f, 0x54, 0xc0, 0x7a, 0x32, 0x23, 0xe4, 0x90, 0x97,
+	0x72, 0xed, 0x49, 0xd8, 0x07, 0xb7, 0x52, 0x3a, 0xf8, 0x2c, 0xdc, 0xe1, 0x78, 0xd3, 0xd4, 0x1c,
+	0xac, 0x70, 0xbb, 0x34, 0x0f, 0x1a, 0x68, 0xe4, 0xc1, 0x08, 0x9c, 0x3f, 0x31, 0x9c, 0x16, 0x1c,
+	0xa3, 0x33, 0x78, 0x23, 0xc7, 0xe2, 0xd4, 0xc3, 0x84, 0x37, 0x39, 0x99, 0x0d, 0x79, 0x9a, 0x32,
+	0x9b, 0x61, 0x55, 0xb8, 0x70, 0xe4, 0x14, 0x91, 0xcb, 0x10, 0x4b, 0xb0, 0x04, 0xe2, 0x3f, 0x14,
+	0xb0, 0xc9, 0x8a, 0xe9, 0xc7, 0x8e, 0x0b, 0xc9, 0xa3, 0xd1, 0x08, 0x3d, 0xb3, 0x83, 0x21, 0xbc,
+	0x36, 0xc1, 0x5d, 0xb0, 0x96, 0x3f, 0x6f, 0x8b, 0xa3, 0x52, 0x47, 0xf5, 0x3e, 0xa8, 0xd8, 0xe9,
+	0xc3, 0xfc, 0xc4, 0x65, 0x0a, 0xba, 0x1e, 0x11, 0xf4, 0xd1, 0x18, 0x26, 0x73, 0x7e, 0xcb, 0xe2,
+	0xd2, 0xa5, 0xbe, 0xb4, 0xc0, 0x4e, 0x61, 0x29, 0xa2, 0xd8, 0xdf, 0xd9, 0xf0, 0x3f, 0x8e, 0x03,
+	0xc7, 0x82, 0xcf, 0xec, 0xc8, 0x79, 0x82, 0xd0, 0x88, 0x16, 0xea, 0xc0, 0x10, 0x61, 0x8f, 0xa0,
+	0xe8, 0xbf, 0x0b, 0x15, 0xae, 0xea, 0x50, 0xda, 0xda, 0x1b, 0x3f, 0x96, 0x3c, 0x35, 0xaf, 0x51,
+	0x3c, 0xca, 0xd7, 0x24, 0x5f, 0x81, 0xa8, 0xef, 0x5b, 0x3e, 0x7d, 0x0e, 0x84, 0x3e, 0x9b, 0x3e,
+	0xa9, 0x19, 0xca, 0xbb, 0x36, 0x63, 0xc1, 0x35, 0xea, 0xd5, 0x28, 0x94, 0xd4, 0xcb, 0x78, 0xa1,
+	0x80, 0x46, 0xfe, 0xb1, 0x14, 0x86, 0xfa, 0x10, 0xdc, 0x8e, 0xa0, 0x03, 0xfd, 0x90, 0x78, 0x28,
+	0xa0, 0x93, 0xab, 0x24, 0x93, 0xab, 0xcd, 0xa6, 0xad, 0x3a, 0x9b, 0xdc, 0x9c, 0xd9, 0xb0, 0x6a,
+	0x99, 0x7c, 0xe4, 0xa8, 0x07, 0xf4, 0x56, 0x4f, 0x50, 0x4c, 0xf8, 0x7e, 0x5e, 0xc1, 0xab, 0xb8,
+	0xdb, 0xd4, 0xbd, 0xfb, 0xa6, 0x0c, 0x4a, 0x03, 0xec, 0xaa, 0x0f, 0x41, 0x25, 0x3b, 0x6d, 0x77,
+	0xf9, 0x76, 0xcb, 0x3f, 0x31, 0xfa, 0xbd, 0x02, 0xa5, 0x80, 0xff, 0x18, 0xd4, 0x72, 0xbf, 0x39,
+	0x8d, 0xcc, 0x59, 0xd6, 0xeb, 0xcd, 0x62, 0xbd, 0xc8, 0xf3, 0x29, 0x58, 0x9f, 0x3b, 0xb3, 0x5a,
+	0x16, 0x91, 0xb7, 0xe8, 0xed, 0x45, 0x16, 0x91, 0xed, 0x10, 0x54, 0xe5, 0xf3, 0xb7, 0x29, 0x57,
+	0x20, 0xd4, 0xfa, 0x4e, 0xa1, 0x5a, 0x4e, 0x22, 0xdf, 0x26, 0x29, 0x89, 0xa4, 0xd6, 0x77, 0x0a,
+	0xd5, 0x22, 0xc9, 0x97, 0x40, 0x2d, 0x38, 0x17, 0xf7, 0x73, 0x2f, 0xcf, 0x59, 0xf5, 0xf7, 0xae,
+	0xb2, 0xca, 0x8c, 0xcd, 0xed, 0xa6, 0xc4, 0x58, 0xde, 0xa2, 0xb7, 0x17, 0x59, 0xf2, 0xc5, 0x66,
+	0xab, 0x90, 0x2b, 0x56, 0xa8, 0xf5, 0x9d, 0x42, 0x75, 0x9a, 0x44, 0x5f, 0xf9, 0xee, 0xed, 0xcb,
+	0x07, 0x4a, 0xff, 0xf3, 0x57, 0xe7, 0x4d, 0xe5, 0xf5, 0x79, 0x53, 0xf9, 0xe7, 0xbc, 0xa9, 0xbc,
+	0xb8, 0x68, 0x2e, 0xbd, 0xbe, 0x68, 0x2e, 0xbd, 0xb9, 0x68, 0x2e, 0x7d, 0x75, 0x20, 0xad, 0x74,
+	0x68, 0x8f, 0x47, 0x30, 0x38, 0x43, 0x24, 0xdd, 0xee, 0xbd, 0x24, 0xf7, 0x9e, 0x8f, 0x9c, 0x78,
+	0x04, 0xcd, 0xe7, 0x26, 0xff, 0xd3, 0x4b, 0xf7, 0xfc, 0x64, 0x35, 0xf9, 0x77, 0xf9, 0xe1, 0xbf,
+	0x03, 0x00, 0xd8, 0x65, 0x8c, 0x6e, 0x0a, 0x0b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// SendKudos sends kudos from one address to another
+	SendKudos(ctx context.Context, in *MsgSendKudos, opts ...grpc.CallOption) (*MsgSendKudosResponse, error)
+	// UpdateParams updates the module parameters, restricted to the module authority
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// MultiSendKudos sends kudos from one address to several recipients atomically
+	MultiSendKudos(ctx context.Context, in *MsgMultiSendKudos, opts ...grpc.CallOption) (*MsgMultiSendKudosResponse, error)
+	// SetCategory creates or updates a kudos category, restricted to the module authority
+	SetCategory(ctx context.Context, in *MsgSetCategory, opts ...grpc.CallOption) (*MsgSetCategoryResponse, error)
+	// RevokeKudos reverses a transfer made by the signer within the revoke grace period
+	RevokeKudos(ctx context.Context, in *MsgRevokeKudos, opts ...grpc.CallOption) (*MsgRevokeKudosResponse, error)
+	// SetBudgetAllowance overrides the per-epoch giveable allowance of an address, restricted to
+	// the module authority and params.budget_admin
+	SetBudgetAllowance(ctx context.Context, in *MsgSetBudgetAllowance, opts ...grpc.CallOption) (*MsgSetBudgetAllowanceResponse, error)
+	// FundRewardPool moves coins from the signer into the reward pool that pays out redeemed kudos
+	FundRewardPool(ctx context.Context, in *MsgFundRewardPool, opts ...grpc.CallOption) (*MsgFundRewardPoolResponse, error)
+	// RedeemKudos burns kudos from the signer's balance in exchange for reward pool coins
+	RedeemKudos(ctx context.Context, in *MsgRedeemKudos, opts ...grpc.CallOption) (*MsgRedeemKudosResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) SendKudos(ctx context.Context, in *MsgSendKudos, opts ...grpc.CallOption) (*MsgSendKudosResponse, error) {
+	out := new(MsgSendKudosResponse)
+	err := c.cc.Invoke(ctx, "/kudos.Msg/SendKudos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/kudos.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MultiSendKudos(ctx context.Context, in *MsgMultiSendKudos, opts ...grpc.CallOption) (*MsgMultiSendKudosResponse, error) {
+	out := new(MsgMultiSendKudosResponse)
+	err := c.cc.Invoke(ctx, "/kudos.Msg/MultiSendKudos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetCategory(ctx context.Context, in *MsgSetCategory, opts ...grpc.CallOption) (*MsgSetCategoryResponse, error) {
+	out := new(MsgSetCategoryResponse)
+	err := c.cc.Invoke(ctx, "/kudos.Msg/SetCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeKudos(ctx context.Context, in *MsgRevokeKudos, opts ...grpc.CallOption) (*MsgRevokeKudosResponse, error) {
+	out := new(MsgRevokeKudosResponse)
+	err := c.cc.Invoke(ctx, "/kudos.Msg/RevokeKudos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetBudgetAllowance(ctx context.Context, in *MsgSetBudgetAllowance, opts ...grpc.CallOption) (*MsgSetBudgetAllowanceResponse, error) {
+	out := new(MsgSetBudgetAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/kudos.Msg/SetBudgetAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundRewardPool(ctx context.Context, in *MsgFundRewardPool, opts ...grpc.CallOption) (*MsgFundRewardPoolResponse, error) {
+	out := new(MsgFundRewardPoolResponse)
+	err := c.cc.Invoke(ctx, "/kudos.Msg/FundRewardPool", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RedeemKudos(ctx context.Context, in *MsgRedeemKudos, opts ...grpc.CallOption) (*MsgRedeemKudosResponse, error) {
+	out := new(MsgRedeemKudosResponse)
+	err := c.cc.Invoke(ctx, "/kudos.Msg/RedeemKudos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// SendKudos sends kudos from one address to another
+	SendKudos(context.Context, *MsgSendKudos) (*MsgSendKudosResponse, error)
+	// UpdateParams updates the module parameters, restricted to the module authority
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// MultiSendKudos sends kudos from one address to several recipients atomically
+	MultiSendKudos(context.Context, *MsgMultiSendKudos) (*MsgMultiSendKudosResponse, error)
+	// SetCategory creates or updates a kudos category, restricted to the module authority
+	SetCategory(context.Context, *MsgSetCategory) (*MsgSetCategoryResponse, error)
+	// RevokeKudos reverses a transfer made by the signer within the revoke grace period
+	RevokeKudos(context.Context, *MsgRevokeKudos) (*MsgRevokeKudosResponse, error)
+	// SetBudgetAllowance overrides the per-epoch giveable allowance of an address, restricted to
+	// the module authority and params.budget_admin
+	SetBudgetAllowance(context.Context, *MsgSetBudgetAllowance) (*MsgSetBudgetAllowanceResponse, error)
+	// FundRewardPool moves coins from the signer into the reward pool that pays out redeemed kudos
+	FundRewardPool(context.Context, *MsgFundRewardPool) (*MsgFundRewardPoolResponse, error)
+	// RedeemKudos burns kudos from the signer's balance in exchange for reward pool coins
+	RedeemKudos(context.Context, *MsgRedeemKudos) (*MsgRedeemKudosResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) SendKudos(ctx context.Context, req *MsgSendKudos) (*MsgSendKudosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendKudos not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) MultiSendKudos(ctx context.Context, req *MsgMultiSendKudos) (*MsgMultiSendKudosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MultiSendKudos not implemented")
+}
+func (*UnimplementedMsgServer) SetCategory(ctx context.Context, req *MsgSetCategory) (*MsgSetCategoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCategory not implemented")
+}
+func (*UnimplementedMsgServer) RevokeKudos(ctx context.Context, req *MsgRevokeKudos) (*MsgRevokeKudosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeKudos not implemented")
+}
+func (*UnimplementedMsgServer) SetBudgetAllowance(ctx context.Context, req *MsgSetBudgetAllowance) (*MsgSetBudgetAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetBudgetAllowance not implemented")
+}
+func (*UnimplementedMsgServer) FundRewardPool(ctx context.Context, req *MsgFundRewardPool) (*MsgFundRewardPoolResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundRewardPool not implemented")
+}
+func (*UnimplementedMsgServer) RedeemKudos(ctx context.Context, req *MsgRedeemKudos) (*MsgRedeemKudosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeemKudos not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_SendKudos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendKudos)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendKudos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudos.Msg/SendKudos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendKudos(ctx, req.(*MsgSendKudos))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudos.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MultiSendKudos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMultiSendKudos)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MultiSendKudos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudos.Msg/MultiSendKudos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MultiSendKudos(ctx, req.(*MsgMultiSendKudos))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetCategory)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudos.Msg/SetCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCategory(ctx, req.(*MsgSetCategory))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeKudos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeKudos)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeKudos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudos.Msg/RevokeKudos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeKudos(ctx, req.(*MsgRevokeKudos))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetBudgetAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetBudgetAllowance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetBudgetAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudos.Msg/SetBudgetAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetBudgetAllowance(ctx, req.(*MsgSetBudgetAllowance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundRewardPool_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundRewardPool)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundRewardPool(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudos.Msg/FundRewardPool",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundRewardPool(ctx, req.(*MsgFundRewardPool))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RedeemKudos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedeemKudos)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RedeemKudos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/kudos.Msg/RedeemKudos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedeemKudos(ctx, req.(*MsgRedeemKudos))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "kudos.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SendKudos",
+			Handler:    _Msg_SendKudos_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "MultiSendKudos",
+			Handler:    _Msg_MultiSendKudos_Handler,
+		},
+		{
+			MethodName: "SetCategory",
+			Handler:    _Msg_SetCategory_Handler,
+		},
+		{
+			MethodName: "RevokeKudos",
+			Handler:    _Msg_RevokeKudos_Handler,
+		},
+		{
+			MethodName: "SetBudgetAllowance",
+			Handler:    _Msg_SetBudgetAllowance_Handler,
+		},
+		{
+			MethodName: "FundRewardPool",
+			Handler:    _Msg_FundRewardPool_Handler,
+		},
+		{
+			MethodName: "RedeemKudos",
+			Handler:    _Msg_RedeemKudos_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "kudos/tx.proto",
+}
+
+func (m *MsgSendKudos) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendKudos) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendKudos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Tip) > 0 {
+		for iNdEx := len(m.Tip) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tip[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.CategoryId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CategoryId))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Comment) > 0 {
+		i -= len(m.Comment)
+		copy(dAtA[i:], m.Comment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Comment)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendKudosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendKudosResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendKudosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *KudosOutput) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *KudosOutput) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *KudosOutput) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CategoryId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CategoryId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Comment) > 0 {
+		i -= len(m.Comment)
+		copy(dAtA[i:], m.Comment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Comment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ToAddress) > 0 {
+		i -= len(m.ToAddress)
+		copy(dAtA[i:], m.ToAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiSendKudos) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiSendKudos) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiSendKudos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Outputs) > 0 {
+		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Outputs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMultiSendKudosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMultiSendKudosResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMultiSendKudosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCategory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCategory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCategory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Category.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCategoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCategoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCategoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeKudos) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeKudos) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeKudos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.HistoryId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.HistoryId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FromAddress) > 0 {
+		i -= len(m.FromAddress)
+		copy(dAtA[i:], m.FromAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FromAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeKudosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeKudosResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeKudosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBudgetAllowance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBudgetAllowance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBudgetAllowance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Remove {
+		i--
+		if m.Remove {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Allowance != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Allowance))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetBudgetAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetBudgetAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetBudgetAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundRewardPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundRewardPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundRewardPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundRewardPoolResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundRewardPoolResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundRewardPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemKudos) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemKudos) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemKudos) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Amount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Amount))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRedeemKudosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRedeemKudosResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRedeemKudosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Payout.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.RedemptionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RedemptionId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSendKudos) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	l = len(m.Comment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CategoryId != 0 {
+		n += 1 + sovTx(uint64(m.CategoryId))
+	}
+	if len(m.Tip) > 0 {
+		for _, e := range m.Tip {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSendKudosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *KudosOutput) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ToAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	l = len(m.Comment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.CategoryId != 0 {
+		n += 1 + sovTx(uint64(m.CategoryId))
+	}
+	return n
+}
+
+func (m *MsgMultiSendKudos) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgMultiSendKudosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetCategory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Category.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetCategoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeKudos) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FromAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.HistoryId != 0 {
+		n += 1 + sovTx(uint64(m.HistoryId))
+	}
+	return n
+}
+
+func (m *MsgRevokeKudosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetBudgetAllowance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Allowance != 0 {
+		n += 1 + sovTx(uint64(m.Allowance))
+	}
+	if m.Remove {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetBudgetAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundRewardPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFundRewardPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRedeemKudos) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Amount != 0 {
+		n += 1 + sovTx(uint64(m.Amount))
+	}
+	return n
+}
+
+func (m *MsgRedeemKudosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RedemptionId != 0 {
+		n += 1 + sovTx(uint64(m.RedemptionId))
+	}
+	l = m.Payout.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSendKudos) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendKudos: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendKudos: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Comment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CategoryId", wireType)
+			}
+			m.CategoryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CategoryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tip", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tip = append(m.Tip, types.Coin{})
+			if err := m.Tip[len(m.Tip)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendKudosResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendKudosResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendKudosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *KudosOutput) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: KudosOutput: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: KudosOutput: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Comment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Comment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CategoryId", wireType)
+			}
+			m.CategoryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CategoryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiSendKudos) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiSendKudos: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiSendKudos: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Outputs = append(m.Outputs, KudosOutput{})
+			if err := m.Outputs[len(m.Outputs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgMultiSendKudosResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgMultiSendKudosResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgMultiSendKudosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetCategory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetCategory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetCategory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Category", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Category.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetCategoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetCategoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetCategoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeKudos) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeKudos: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeKudos: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FromAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FromAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HistoryId", wireType)
+			}
+			m.HistoryId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HistoryId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeKudosResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeKudosResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeKudosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetBudgetAllowance) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBudgetAllowance: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBudgetAllowance: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowance", wireType)
+			}
+			m.Allowance = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Allowance |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Remove", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Remove = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetBudgetAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetBudgetAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetBudgetAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundRewardPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundRewardPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundRewardPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundRewardPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundRewardPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundRewardPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedeemKudos) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedeemKudos: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedeemKudos: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			m.Amount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Amount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRedeemKudosResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRedeemKudosResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRedeemKudosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionId", wireType)
+			}
+			m.RedemptionId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RedemptionId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Payout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)